@@ -14,3 +14,36 @@ type Arena[T any] interface {
 	Offset() int
 	Base() unsafe.Pointer
 }
+
+// Resizer is implemented by arenas whose capacity can change after creation.
+type Resizer interface {
+	// Resize changes the capacity to newSize bytes without moving live objects.
+	// It fails with ErrNewSizeTooSmall if newSize is below Offset() and with
+	// ErrOutOfMemory if the new storage cannot be obtained.
+	Resize(newSize int) error
+}
+
+// Mark is an opaque checkpoint taken by Rewinder.Mark.
+type Mark struct {
+	owner  unsafe.Pointer // arena that issued the mark
+	base   unsafe.Pointer // region the offset refers to
+	gen    uint64         // arena generation at the time of the mark
+	offset int
+}
+
+// Rewinder is implemented by arenas that can roll back to a checkpoint.
+type Rewinder interface {
+	// Mark records the current allocation position.
+	Mark() Mark
+	// Rewind zeroes and releases everything allocated after m.  It fails with
+	// ErrInvalidMark if m came from another arena, predates a Reset, or lies
+	// beyond the current position.
+	Rewind(m Mark) error
+}
+
+// Allocator is the untyped part of an arena: anything that hands out raw
+// memory, such as RawArena, SlabAllocator or an Arena[byte].
+type Allocator interface {
+	// Allocate reserves sz bytes and returns a pointer to the start.
+	Allocate(sz int) (unsafe.Pointer, error)
+}
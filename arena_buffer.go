@@ -0,0 +1,146 @@
+package memoryArena
+
+import (
+	"io"
+	"unsafe"
+)
+
+// ArenaBuffer is a bytes.Buffer replacement whose contents live in arena
+// memory, so encoders can produce output directly where it is kept instead of
+// writing to a heap buffer and copying.  When it runs out of capacity it moves
+// to a new region of the next power‑of‑two size, like AppendSlice; outgrown
+// regions stay in the arena until it is Reset.
+//
+// An ArenaBuffer is NOT goroutine‑safe, and its contents are invalidated by
+// the Reset of the arena it allocates from.
+type ArenaBuffer struct {
+	alloc Allocator
+	buf   []byte
+}
+
+// minRead is the free space ReadFrom makes sure of before each Read.
+const minRead = 512
+
+// NewArenaBuffer returns an empty buffer that allocates from a, with room for
+// capacity bytes before it has to grow.
+func NewArenaBuffer(a Allocator, capacity int) (*ArenaBuffer, error) {
+	if capacity < 0 {
+		return nil, ErrInvalidSize
+	}
+	b := &ArenaBuffer{alloc: a}
+	if err := b.grow(capacity); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// grow makes sure n more bytes fit.  On failure the buffer is unchanged.
+func (b *ArenaBuffer) grow(n int) error {
+	need := len(b.buf) + n
+	if need <= cap(b.buf) || need == 0 {
+		return nil
+	}
+	newCap := nextPow2(need)
+	ptr, err := b.alloc.Allocate(newCap)
+	if err != nil {
+		return err
+	}
+	buf := unsafe.Slice((*byte)(ptr), newCap)
+	b.buf = buf[:copy(buf, b.buf)]
+	return nil
+}
+
+// Grow makes sure at least n more bytes can be written without another
+// allocation.
+func (b *ArenaBuffer) Grow(n int) error {
+	if n < 0 {
+		return ErrInvalidSize
+	}
+	return b.grow(n)
+}
+
+// Write appends p.  If the buffer cannot grow, nothing is written and the
+// arena's error is returned.
+func (b *ArenaBuffer) Write(p []byte) (int, error) {
+	if err := b.grow(len(p)); err != nil {
+		return 0, err
+	}
+	b.buf = append(b.buf, p...)
+	return len(p), nil
+}
+
+// WriteByte appends c.
+func (b *ArenaBuffer) WriteByte(c byte) error {
+	if err := b.grow(1); err != nil {
+		return err
+	}
+	b.buf = append(b.buf, c)
+	return nil
+}
+
+// WriteString appends s without converting it to a []byte first.
+func (b *ArenaBuffer) WriteString(s string) (int, error) {
+	if err := b.grow(len(s)); err != nil {
+		return 0, err
+	}
+	b.buf = append(b.buf, s...)
+	return len(s), nil
+}
+
+// ReadFrom appends data read from r until EOF and returns the number of bytes
+// read.  io.EOF is not reported as an error.
+func (b *ArenaBuffer) ReadFrom(r io.Reader) (int64, error) {
+	var total int64
+	for {
+		if cap(b.buf)-len(b.buf) < minRead {
+			if err := b.grow(minRead); err != nil {
+				return total, err
+			}
+		}
+		n, err := r.Read(b.buf[len(b.buf):cap(b.buf)])
+		if n < 0 {
+			panic("memory arena: reader returned negative count from Read")
+		}
+		b.buf = b.buf[:len(b.buf)+n]
+		total += int64(n)
+		if err == io.EOF {
+			return total, nil
+		}
+		if err != nil {
+			return total, err
+		}
+	}
+}
+
+// WriteTo writes the contents to w.  Unlike bytes.Buffer it does not consume
+// them: the bytes stay in the arena for as long as the caller needs them.
+func (b *ArenaBuffer) WriteTo(w io.Writer) (int64, error) {
+	n, err := w.Write(b.buf)
+	if err == nil && n < len(b.buf) {
+		err = io.ErrShortWrite
+	}
+	return int64(n), err
+}
+
+// Bytes returns the contents without copying.  The slice aliases arena memory
+// and is valid until the next write that grows the buffer or the arena's
+// Reset, whichever comes first.
+func (b *ArenaBuffer) Bytes() []byte {
+	return b.buf
+}
+
+// Len returns the number of bytes written.
+func (b *ArenaBuffer) Len() int {
+	return len(b.buf)
+}
+
+// Cap returns the capacity of the current region.
+func (b *ArenaBuffer) Cap() int {
+	return cap(b.buf)
+}
+
+// Reset empties the buffer but keeps its region, so later writes overwrite
+// what earlier Bytes calls returned.
+func (b *ArenaBuffer) Reset() {
+	b.buf = b.buf[:0]
+}
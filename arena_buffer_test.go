@@ -0,0 +1,114 @@
+package memoryArena
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"unsafe"
+)
+
+var (
+	_ io.Writer       = (*ArenaBuffer)(nil)
+	_ io.ByteWriter   = (*ArenaBuffer)(nil)
+	_ io.StringWriter = (*ArenaBuffer)(nil)
+	_ io.ReaderFrom   = (*ArenaBuffer)(nil)
+	_ io.WriterTo     = (*ArenaBuffer)(nil)
+
+	_ Allocator = (*RawArena)(nil)
+	_ Allocator = (*SlabAllocator)(nil)
+	_ Allocator = Arena[byte](nil)
+)
+
+func TestArenaBuffer_Writes(t *testing.T) {
+	a, _ := NewRawArena(4096)
+	b, err := NewArenaBuffer(a, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Write([]byte("hello"))
+	b.WriteByte(' ')
+	b.WriteString(strings.Repeat("x", 100))
+	if b.Len() != 106 || b.Cap() != 128 {
+		t.Fatalf("Len/Cap = %d/%d, want 106/128", b.Len(), b.Cap())
+	}
+	if got := string(b.Bytes()); got != "hello "+strings.Repeat("x", 100) {
+		t.Fatalf("contents %q", got)
+	}
+	p := uintptr(unsafe.Pointer(&b.Bytes()[0]))
+	if p < uintptr(a.Base()) || p >= uintptr(a.Base())+uintptr(a.Capacity()) {
+		t.Fatalf("Bytes does not point into the arena")
+	}
+}
+
+func TestArenaBuffer_Encoder(t *testing.T) {
+	a, _ := NewChunkedArena[byte](256, GrowthPolicy{Mode: GrowDoubling})
+	b, _ := NewArenaBuffer(a, 64)
+	v := map[string][]int{"primes": {2, 3, 5, 7, 11, 13}}
+	for i := 0; i < 20; i++ {
+		if err := json.NewEncoder(b).Encode(v); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want := strings.Repeat(`{"primes":[2,3,5,7,11,13]}`+"\n", 20)
+	if string(b.Bytes()) != want {
+		t.Fatalf("encoded output mismatch")
+	}
+}
+
+func TestArenaBuffer_ReadFromWriteTo(t *testing.T) {
+	a, _ := NewMemoryArena[byte](1 << 16)
+	b, _ := NewArenaBuffer(a, 0)
+	src := strings.Repeat("0123456789", 300)
+	n, err := b.ReadFrom(strings.NewReader(src))
+	if err != nil || n != int64(len(src)) {
+		t.Fatalf("ReadFrom = %d, %v", n, err)
+	}
+	var out bytes.Buffer
+	if m, err := b.WriteTo(&out); err != nil || m != n {
+		t.Fatalf("WriteTo = %d, %v", m, err)
+	}
+	if out.String() != src || b.Len() != len(src) {
+		t.Fatalf("round trip mismatch or WriteTo consumed the buffer")
+	}
+
+	boom := errors.New("boom")
+	b.Reset()
+	if _, err := b.ReadFrom(io.MultiReader(strings.NewReader("ab"), iotest.ErrReader(boom))); err != boom {
+		t.Fatalf("want reader error, got %v", err)
+	}
+	if string(b.Bytes()) != "ab" {
+		t.Fatalf("bytes read before the error lost: %q", b.Bytes())
+	}
+}
+
+func TestArenaBuffer_ArenaFull(t *testing.T) {
+	a, _ := NewRawArena(64)
+	b, _ := NewArenaBuffer(a, 16)
+	b.WriteString("0123456789")
+	if n, err := b.Write(make([]byte, 40)); err != ErrArenaFull || n != 0 {
+		t.Fatalf("Write = %d, %v; want 0, ErrArenaFull", n, err)
+	}
+	if b.Len() != 10 || string(b.Bytes()) != "0123456789" {
+		t.Fatalf("failed Write changed the buffer: %q", b.Bytes())
+	}
+	if _, err := NewArenaBuffer(a, -1); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+}
+
+func BenchmarkArenaBuffer_Write(b *testing.B) {
+	a, _ := NewRawArena(1 << 20)
+	chunk := []byte(strings.Repeat("z", 100))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf, _ := NewArenaBuffer(a, 0)
+		for j := 0; j < 20; j++ {
+			buf.Write(chunk)
+		}
+		a.Reset()
+	}
+}
@@ -0,0 +1,133 @@
+package memoryArena
+
+import (
+	"math/bits"
+	"sync"
+	"time"
+)
+
+// PoolOptions configures an ArenaPool.
+type PoolOptions struct {
+	// MaxPerBucket caps the idle arenas kept per capacity bucket; arenas put
+	// into a full bucket are dropped.  0 means 8.
+	MaxPerBucket int
+	// IdleTimeout drops arenas that sat unused in the pool for longer.  0
+	// keeps them until they are reused.
+	IdleTimeout time.Duration
+}
+
+// ArenaPool recycles arenas between units of work.  Get returns an empty
+// arena with at least the requested capacity and Put Resets an arena before
+// keeping it, so nothing from the previous user leaks into the next one.
+// Arenas are bucketed by power‑of‑two capacity; each bucket keeps at most
+// MaxPerBucket of them and, with an IdleTimeout, arenas left unused for too
+// long are trimmed on later Get and Put calls or by Trim.
+//
+// The pool works with any constructor of the NewMemoryArena shape –
+// NewMemoryArena, NewConcurrentArena, NewAtomicArena – and is safe for
+// concurrent use.
+type ArenaPool[T any] struct {
+	mu       sync.Mutex
+	buckets  [bits.UintSize][]idleArena[T] // by floor(log2(capacity)), oldest first
+	newArena func(size int) (Arena[T], error)
+	opts     PoolOptions
+	now      func() time.Time
+}
+
+type idleArena[T any] struct {
+	arena Arena[T]
+	since time.Time
+}
+
+// NewArenaPool returns a pool that creates arenas with newArena; nil means
+// NewMemoryArena.
+func NewArenaPool[T any](newArena func(size int) (Arena[T], error), opts PoolOptions) *ArenaPool[T] {
+	if newArena == nil {
+		newArena = NewMemoryArena[T]
+	}
+	if opts.MaxPerBucket <= 0 {
+		opts.MaxPerBucket = 8
+	}
+	return &ArenaPool[T]{newArena: newArena, opts: opts, now: time.Now}
+}
+
+// Get returns an empty arena with at least minSize bytes of capacity, reusing
+// a pooled one from minSize's power‑of‑two bucket – less than twice minSize
+// rounded up to a power of two – before creating a new arena.
+func (p *ArenaPool[T]) Get(minSize int) (Arena[T], error) {
+	if minSize <= 0 {
+		return nil, ErrInvalidSize
+	}
+	size := nextPow2(minSize)
+	b := bits.Len(uint(size)) - 1
+	p.mu.Lock()
+	p.trim(p.now())
+	if n := len(p.buckets[b]); n > 0 {
+		a := p.buckets[b][n-1].arena
+		p.buckets[b][n-1] = idleArena[T]{}
+		p.buckets[b] = p.buckets[b][:n-1]
+		p.mu.Unlock()
+		return a, nil
+	}
+	p.mu.Unlock()
+	return p.newArena(size)
+}
+
+// Put Resets a and keeps it for a later Get.  It is dropped instead when its
+// bucket is full or when it does not implement StatsReporter, which the pool
+// needs to learn its capacity.
+func (p *ArenaPool[T]) Put(a Arena[T]) {
+	sr, ok := a.(StatsReporter)
+	if !ok {
+		return
+	}
+	capacity := sr.Stats().Capacity
+	if capacity <= 0 {
+		return
+	}
+	a.Reset()
+	b := bits.Len(uint(capacity)) - 1
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	now := p.now()
+	p.trim(now)
+	if len(p.buckets[b]) >= p.opts.MaxPerBucket {
+		return
+	}
+	p.buckets[b] = append(p.buckets[b], idleArena[T]{arena: a, since: now})
+}
+
+// Trim drops every arena that has been idle for longer than IdleTimeout.
+func (p *ArenaPool[T]) Trim() {
+	p.mu.Lock()
+	p.trim(p.now())
+	p.mu.Unlock()
+}
+
+func (p *ArenaPool[T]) trim(now time.Time) {
+	if p.opts.IdleTimeout <= 0 {
+		return
+	}
+	for b, idle := range p.buckets {
+		n := 0
+		for n < len(idle) && now.Sub(idle[n].since) > p.opts.IdleTimeout {
+			n++
+		}
+		if n > 0 {
+			m := copy(idle, idle[n:])
+			clear(idle[m:])
+			p.buckets[b] = idle[:m]
+		}
+	}
+}
+
+// Idle returns the number of arenas currently kept by the pool.
+func (p *ArenaPool[T]) Idle() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	n := 0
+	for _, idle := range p.buckets {
+		n += len(idle)
+	}
+	return n
+}
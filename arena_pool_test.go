@@ -0,0 +1,134 @@
+package memoryArena
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestArenaPool_ReusesAndResets(t *testing.T) {
+	constructors := map[string]func(int) (Arena[int], error){
+		"MemoryArena":     NewMemoryArena[int],
+		"ConcurrentArena": NewConcurrentArena[int],
+		"AtomicArena":     NewAtomicArena[int],
+	}
+	for name, newArena := range constructors {
+		t.Run(name, func(t *testing.T) {
+			p := NewArenaPool(newArena, PoolOptions{})
+			a, err := p.Get(1000)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c := a.(StatsReporter).Stats().Capacity; c < 1000 {
+				t.Fatalf("capacity %d below the requested 1000", c)
+			}
+			a.NewObject(7)
+			p.Put(a)
+			if p.Idle() != 1 {
+				t.Fatalf("Idle %d after Put", p.Idle())
+			}
+			b, _ := p.Get(600) // same 1024 bucket
+			if b != a {
+				t.Fatalf("pooled arena not reused")
+			}
+			if b.Offset() != 0 {
+				t.Fatalf("Put did not Reset the arena: offset %d", b.Offset())
+			}
+		})
+	}
+}
+
+func TestArenaPool_Buckets(t *testing.T) {
+	p := NewArenaPool[byte](nil, PoolOptions{})
+	if _, err := p.Get(0); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+	small, _ := p.Get(100)
+	p.Put(small)
+	if a, _ := p.Get(4096); a == small {
+		t.Fatalf("Get(4096) returned a 128‑byte arena")
+	}
+	if a, _ := p.Get(100); a != small {
+		t.Fatalf("pooled arena of the request's bucket ignored")
+	}
+	big, _ := p.Get(256)
+	p.Put(big)
+	// Only the request's own bucket is reused: 256 bytes for 65 would be
+	// nearly four times the request.
+	if a, _ := p.Get(65); a == big {
+		t.Fatalf("Get(65) returned a 256‑byte arena")
+	}
+	if a, _ := p.Get(129); a != big {
+		t.Fatalf("pooled 256‑byte arena ignored for Get(129)")
+	}
+}
+
+func TestArenaPool_MaxPerBucket(t *testing.T) {
+	p := NewArenaPool[int](nil, PoolOptions{MaxPerBucket: 2})
+	for i := 0; i < 5; i++ {
+		a, _ := NewMemoryArena[int](512)
+		p.Put(a)
+	}
+	if p.Idle() != 2 {
+		t.Fatalf("Idle %d, want the bucket cap of 2", p.Idle())
+	}
+}
+
+func TestArenaPool_IdleTrim(t *testing.T) {
+	p := NewArenaPool[int](nil, PoolOptions{IdleTimeout: time.Minute})
+	now := time.Unix(0, 0)
+	p.now = func() time.Time { return now }
+
+	old, _ := p.Get(64)
+	p.Put(old)
+	now = now.Add(50 * time.Second)
+	fresh, _ := p.Get(4096)
+	p.Put(fresh)
+
+	now = now.Add(20 * time.Second) // old idle 70s, fresh 20s
+	p.Trim()
+	if p.Idle() != 1 {
+		t.Fatalf("Idle %d after Trim, want 1", p.Idle())
+	}
+	if a, _ := p.Get(4096); a != fresh {
+		t.Fatalf("recently used arena was trimmed")
+	}
+	if a, _ := p.Get(64); a == old {
+		t.Fatalf("expired arena handed out")
+	}
+}
+
+func TestArenaPool_Concurrent(t *testing.T) {
+	p := NewArenaPool(NewAtomicArena[int], PoolOptions{})
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < 500; i++ {
+				a, err := p.Get(256 << (i % 3))
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				if a.Offset() != 0 {
+					t.Error("Get returned a dirty arena")
+					return
+				}
+				a.NewObject(i)
+				p.Put(a)
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+func BenchmarkArenaPool_GetPut(b *testing.B) {
+	p := NewArenaPool[int](nil, PoolOptions{})
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		a, _ := p.Get(4096)
+		a.NewObject(i)
+		p.Put(a)
+	}
+}
@@ -12,48 +12,57 @@ import (
 
 type AtomicArena[T any] struct {
 	buffer    []byte         // backing storage (kept to satisfy GC & checkptr)
+	objects   []T            // typed backing storage when T holds pointers
 	base      unsafe.Pointer // first aligned byte inside buffer
 	size      uintptr        // usable capacity in bytes
+	reserved  uintptr        // bytes the backing provides past base (≥ size)
 	alignMask uintptr        // alignment-1 of T
 	elemSize  uintptr        // sizeof(T)
 	offset    uint64         // current allocation offset in bytes (atomic)
+	pointers  bool           // backing is GC‑scanned; allocations are whole T slots
+	retired   []region[T]    // backings outgrown by Resize, alive until Reset
+	gen       uint64         // bumped by Reset; invalidates marks and handles (atomic)
+	stats     counters       // see Stats (atomic)
 	zeroBuf   []byte         // for unit‐test expectations
 }
 
 // NewAtomicArena allocates an arena with at least `size` bytes of usable space.
-// Returned addresses are naturally aligned for *T.
+// Returned addresses are naturally aligned for *T.  Pointer‑bearing T get
+// GC‑visible backing storage, as in NewMemoryArena.
 func NewAtomicArena[T any](size int) (Arena[T], error) {
 	if size <= 0 {
 		return nil, ErrInvalidSize
 	}
 	var dummy T
-	alignment := uintptr(unsafe.Alignof(dummy))
-	alignMask := alignment - 1
-	elemSize := uintptr(unsafe.Sizeof(dummy))
-
-	buf := make([]byte, size+int(alignment))
-	raw := uintptr(unsafe.Pointer(&buf[0]))
-	off := uintptr(0)
-	if rem := raw & alignMask; rem != 0 {
-		off = alignment - rem
-	}
-	basePtr := unsafe.Pointer(&buf[off])
+	buf, objs, basePtr, usable := newBacking[T](size)
 
 	return &AtomicArena[T]{
 		buffer:    buf,
+		objects:   objs,
 		base:      basePtr,
-		size:      uintptr(size),
-		alignMask: alignMask,
-		elemSize:  elemSize,
+		size:      uintptr(usable),
+		reserved:  uintptr(usable),
+		alignMask: uintptr(unsafe.Alignof(dummy)) - 1,
+		elemSize:  uintptr(unsafe.Sizeof(dummy)),
 		offset:    0,
+		pointers:  objs != nil,
 	}, nil
 }
 
 // Allocate reserves sz bytes from the arena, aligned to T's alignment, returning a pointer.
 func (a *AtomicArena[T]) Allocate(sz int) (unsafe.Pointer, error) {
+	atomic.AddUint64(&a.stats.allocs, 1)
+	return a.allocate(sz)
+}
+
+// allocate is Allocate without the call counter, shared with NewObject.
+func (a *AtomicArena[T]) allocate(sz int) (unsafe.Pointer, error) {
 	if sz <= 0 {
 		return nil, ErrInvalidSize
 	}
+	if a.pointers {
+		sz = roundSlots(sz, int(a.elemSize))
+	}
 	szU := uintptr(sz)
 	for {
 		// load current offset
@@ -64,12 +73,16 @@ func (a *AtomicArena[T]) Allocate(sz int) (unsafe.Pointer, error) {
 		end := off + szU
 		// boundary check
 		if end > a.size {
+			atomic.AddUint64(&a.stats.failures, 1)
 			return nil, ErrArenaFull
 		}
 		// try CAS
 		newHead := uint64(end)
 		if atomic.CompareAndSwapUint64(&a.offset, head, newHead) {
 			// success
+			if off != off0 {
+				atomic.AddUint64(&a.stats.padding, uint64(off-off0))
+			}
 			return unsafe.Add(a.base, off), nil
 		}
 		// else retry
@@ -78,7 +91,8 @@ func (a *AtomicArena[T]) Allocate(sz int) (unsafe.Pointer, error) {
 
 // NewObject allocates space for T, copies obj into it, and returns *T.
 func (a *AtomicArena[T]) NewObject(obj T) (*T, error) {
-	ptr, err := a.Allocate(int(a.elemSize))
+	atomic.AddUint64(&a.stats.objects, 1)
+	ptr, err := a.allocate(int(a.elemSize))
 	if err != nil {
 		return nil, err
 	}
@@ -90,6 +104,9 @@ func (a *AtomicArena[T]) NewObject(obj T) (*T, error) {
 // Reset zeros used memory and resets the offset to zero.
 // Not safe to call concurrently with Allocate.
 func (a *AtomicArena[T]) Reset() {
+	atomic.AddUint64(&a.gen, 1)
+	atomic.StoreUint64(&a.stats.peak, 0)
+	a.retired = nil
 	head := atomic.LoadUint64(&a.offset)
 	if head == 0 {
 		return
@@ -97,12 +114,74 @@ func (a *AtomicArena[T]) Reset() {
 	if a.zeroBuf == nil {
 		a.zeroBuf = make([]byte, len(a.buffer))
 	}
-	memclrNoHeapPointers(a.base, uintptr(head))
+	clearRange[T](a.base, 0, int(head), a.pointers)
+	atomic.StoreUint64(&a.offset, 0)
+}
+
+// Resize changes the arena's capacity to newSize bytes with the semantics of
+// MemoryArena.Resize.  Like Reset it must only be called while no allocations
+// are in flight.
+func (a *AtomicArena[T]) Resize(newSize int) error {
+	head := int(atomic.LoadUint64(&a.offset))
+	if newSize <= 0 {
+		return ErrInvalidSize
+	}
+	if newSize < head {
+		return ErrNewSizeTooSmall
+	}
+	if a.pointers {
+		newSize = roundSlots(newSize, int(a.elemSize))
+	}
+	if uintptr(newSize) <= a.reserved {
+		a.size = uintptr(newSize)
+		return nil
+	}
+	buf, objs, base, usable, err := growBacking[T](newSize)
+	if err != nil {
+		return err
+	}
+	if head > 0 {
+		a.retired = append(a.retired, region[T]{buffer: a.buffer, objects: a.objects})
+	}
+	a.stats.raisePeak(uint64(head))
+	a.buffer, a.objects, a.base = buf, objs, base
+	a.size, a.reserved = uintptr(usable), uintptr(usable)
 	atomic.StoreUint64(&a.offset, 0)
+	return nil
 }
 
-// AppendSlice appends elems to slice backed by this arena, resizing via the arena when needed.
+// Mark returns a checkpoint of the current allocation offset.  It is safe to
+// call concurrently with Allocate, but the checkpoint only covers allocations
+// that completed before it was taken.
+func (a *AtomicArena[T]) Mark() Mark {
+	return Mark{
+		owner:  unsafe.Pointer(a),
+		base:   a.base,
+		gen:    atomic.LoadUint64(&a.gen),
+		offset: int(atomic.LoadUint64(&a.offset)),
+	}
+}
+
+// Rewind zeroes everything allocated after m and moves the offset back to it,
+// rejecting invalid marks like MemoryArena.Rewind.  Like Reset it must only be
+// called while no allocations are in flight: anything allocated concurrently
+// after m is released as well.
+func (a *AtomicArena[T]) Rewind(m Mark) error {
+	head := int(atomic.LoadUint64(&a.offset))
+	if m.owner != unsafe.Pointer(a) || m.base != a.base || m.gen != atomic.LoadUint64(&a.gen) || m.offset > head {
+		return ErrInvalidMark
+	}
+	a.stats.raisePeak(uint64(head))
+	clearRange[T](a.base, m.offset, head, a.pointers)
+	atomic.StoreUint64(&a.offset, uint64(m.offset))
+	return nil
+}
+
+// AppendSlice appends elems to slice backed by this arena.  A slice that ends
+// exactly at the current offset grows in place with a CAS on the offset; any
+// other slice that outgrows its capacity is copied to a fresh block.
 func (a *AtomicArena[T]) AppendSlice(slice []T, elems ...T) ([]T, error) {
+	atomic.AddUint64(&a.stats.appends, 1)
 	if len(elems) == 0 {
 		return slice, nil
 	}
@@ -111,18 +190,36 @@ func (a *AtomicArena[T]) AppendSlice(slice []T, elems ...T) ([]T, error) {
 	if need <= cap(slice) {
 		return append(slice, elems...), nil
 	}
-	newCap := nextPow2(need)
-	sz := uintptr(newCap) * a.elemSize
+	start := uintptr(unsafe.Pointer(unsafe.SliceData(slice)))
+	sliceInArena := cap(slice) > 0 && start >= uintptr(a.base) && start < uintptr(a.base)+a.size
 	for {
 		head := atomic.LoadUint64(&a.offset)
 		off0 := uintptr(head)
-		off := (off0 + a.alignMask) &^ a.alignMask
-		end := off + sz
-		if end > a.size {
+		in, off := false, (off0+a.alignMask)&^a.alignMask
+		if sliceInArena {
+			// Top of the arena: extend the block instead of moving it.
+			if s := start - uintptr(a.base); s+uintptr(cap(slice))*a.elemSize == off0 {
+				in, off = true, s
+			}
+		}
+		newCap := 0
+		if off <= a.size {
+			newCap = growCap(need, int((a.size-off)/a.elemSize))
+		}
+		if newCap < need {
+			atomic.AddUint64(&a.stats.failures, 1)
 			return nil, ErrArenaFull
 		}
+		end := off + uintptr(newCap)*a.elemSize
 		if atomic.CompareAndSwapUint64(&a.offset, head, uint64(end)) {
 			newArr := unsafe.Slice((*T)(unsafe.Add(a.base, off)), newCap)
+			if in {
+				copy(newArr[sliceLen:], elems)
+				return newArr[:need], nil
+			}
+			if off != off0 {
+				atomic.AddUint64(&a.stats.padding, uint64(off-off0))
+			}
 			n := copy(newArr, slice)
 			copy(newArr[n:], elems)
 			return newArr[:need], nil
@@ -131,9 +228,32 @@ func (a *AtomicArena[T]) AppendSlice(slice []T, elems ...T) ([]T, error) {
 }
 
 func (a *AtomicArena[T]) Offset() int {
-	return int(a.offset)
+	return int(atomic.LoadUint64(&a.offset))
 }
 
 func (a *AtomicArena[T]) Base() unsafe.Pointer {
 	return a.base
 }
+
+// Stats returns a snapshot of the arena's allocation counters.  Counters are
+// read one by one, so a snapshot taken during allocations may be torn.
+func (a *AtomicArena[T]) Stats() Stats {
+	head := atomic.LoadUint64(&a.offset)
+	a.stats.raisePeak(head)
+	return Stats{
+		Capacity: int(a.size),
+		InUse:    int(head),
+		Peak:     int(atomic.LoadUint64(&a.stats.peak)),
+		Allocs:   atomic.LoadUint64(&a.stats.allocs),
+		Objects:  atomic.LoadUint64(&a.stats.objects),
+		Appends:  atomic.LoadUint64(&a.stats.appends),
+		Padding:  atomic.LoadUint64(&a.stats.padding),
+		Failures: atomic.LoadUint64(&a.stats.failures),
+		Resets:   atomic.LoadUint64(&a.gen),
+	}
+}
+
+// Generation returns the number of times the arena has been reset.
+func (a *AtomicArena[T]) Generation() uint64 {
+	return atomic.LoadUint64(&a.gen)
+}
@@ -119,3 +119,51 @@ func BenchmarkAtomicArena_Allocate(b *testing.B) {
 		})
 	}
 }
+
+func TestAtomicArena_Resize(t *testing.T) {
+	arena, _ := NewAtomicArena[int](64)
+	r := arena.(Resizer)
+	p, _ := arena.NewObject(1)
+	if err := r.Resize(4); err != ErrNewSizeTooSmall {
+		t.Fatalf("want ErrNewSizeTooSmall, got %v", err)
+	}
+	if err := r.Resize(8); err != nil {
+		t.Fatalf("shrink: %v", err)
+	}
+	if _, err := arena.NewObject(2); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull after shrink, got %v", err)
+	}
+	if err := r.Resize(512); err != nil {
+		t.Fatalf("grow: %v", err)
+	}
+	for i := 0; i < 64; i++ {
+		if _, err := arena.NewObject(i); err != nil {
+			t.Fatalf("NewObject after grow: %v", err)
+		}
+	}
+	if *p != 1 {
+		t.Fatalf("live object changed after Resize: %d", *p)
+	}
+}
+
+func TestAtomicArena_MarkRewind(t *testing.T) {
+	arena, _ := NewAtomicArena[int](256)
+	r := arena.(Rewinder)
+	arena.NewObject(1)
+	m := r.Mark()
+	arena.NewObject(2)
+	arena.NewObject(3)
+	if err := r.Rewind(m); err != nil {
+		t.Fatalf("Rewind: %v", err)
+	}
+	if arena.Offset() != 8 {
+		t.Fatalf("offset %d, want 8", arena.Offset())
+	}
+	if p, _ := arena.NewObject(0); *p != 0 {
+		t.Fatalf("rewound memory not zeroed: %d", *p)
+	}
+	arena.Reset()
+	if err := r.Rewind(m); err != ErrInvalidMark {
+		t.Fatalf("want ErrInvalidMark after Reset, got %v", err)
+	}
+}
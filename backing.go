@@ -0,0 +1,100 @@
+package memoryArena
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// Backing storage shared by the arena implementations.
+//
+// A plain []byte is invisible to the garbage collector, which is exactly what
+// we want for pointer‑free T: nothing to scan, and Reset can use
+// memclrNoHeapPointers.  Once T contains pointers (strings, slices, maps, *U,
+// interfaces …) the objects stored in the arena are the only thing keeping
+// their referents alive, so the memory must be typed.  In that case the arena
+// is backed by a []T and every allocation is rounded up to whole T slots so
+// the GC's pointer bitmap always lines up with the values written into it.
+
+// hasPointers reports whether values of T hold pointers the GC has to trace.
+func hasPointers[T any]() bool {
+	return typeHasPointers(reflect.TypeOf((*T)(nil)).Elem())
+}
+
+func typeHasPointers(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Pointer, reflect.UnsafePointer, reflect.String, reflect.Slice,
+		reflect.Map, reflect.Chan, reflect.Func, reflect.Interface:
+		return true
+	case reflect.Array:
+		return t.Len() > 0 && typeHasPointers(t.Elem())
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if typeHasPointers(t.Field(i).Type) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// newBacking reserves at least size usable bytes for T.  It returns the byte
+// buffer (pointer‑free T) or the typed slice (pointer‑bearing T) that owns the
+// memory, the first address aligned for T and the usable capacity in bytes.
+func newBacking[T any](size int) (buf []byte, objs []T, base unsafe.Pointer, usable int) {
+	var dummy T
+	alignment := int(unsafe.Alignof(dummy))
+	elemSize := int(unsafe.Sizeof(dummy))
+
+	if elemSize > 0 && hasPointers[T]() {
+		n := (size + elemSize - 1) / elemSize
+		objs = make([]T, n)
+		return nil, objs, unsafe.Pointer(&objs[0]), n * elemSize
+	}
+
+	buf = make([]byte, size+alignment) // +alignment for padding
+	raw := uintptr(unsafe.Pointer(&buf[0]))
+	off := 0
+	if rem := int(raw) & (alignment - 1); rem != 0 {
+		off = alignment - rem
+	}
+	return buf, nil, unsafe.Pointer(&buf[off]), size
+}
+
+// roundSlots rounds sz up to a whole number of elemSize slots.
+func roundSlots(sz, elemSize int) int {
+	return (sz + elemSize - 1) / elemSize * elemSize
+}
+
+// clearRange zeroes bytes [from, to) above base.  Typed memory is cleared
+// through a []T so the write barrier sees the pointers being dropped.
+func clearRange[T any](base unsafe.Pointer, from, to int, pointers bool) {
+	if to <= from {
+		return
+	}
+	if pointers {
+		var dummy T
+		n := (to - from) / int(unsafe.Sizeof(dummy))
+		clear(unsafe.Slice((*T)(unsafe.Add(base, from)), n))
+		return
+	}
+	memclrNoHeapPointers(unsafe.Add(base, from), uintptr(to-from))
+}
+
+// region is a backing outgrown by Resize.  It is only referenced to keep the
+// objects allocated in it alive until the next Reset.
+type region[T any] struct {
+	buffer  []byte
+	objects []T
+}
+
+// growBacking is newBacking for Resize: the runtime panic raised for sizes
+// the heap cannot represent is reported as ErrOutOfMemory instead.
+func growBacking[T any](size int) (buf []byte, objs []T, base unsafe.Pointer, usable int, err error) {
+	defer func() {
+		if recover() != nil {
+			err = ErrOutOfMemory
+		}
+	}()
+	buf, objs, base, usable = newBacking[T](size)
+	return buf, objs, base, usable, nil
+}
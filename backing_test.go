@@ -0,0 +1,139 @@
+package memoryArena
+
+import (
+	"fmt"
+	"runtime"
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+type person struct {
+	Name string
+	Tags []string
+	Meta map[string]int
+	Next *person
+	Age  int
+}
+
+func TestHasPointers(t *testing.T) {
+	cases := []struct {
+		name string
+		got  bool
+		want bool
+	}{
+		{"int", hasPointers[int](), false},
+		{"point", hasPointers[point](), false},
+		{"[4]byte", hasPointers[[4]byte](), false},
+		{"[0]*int", hasPointers[[0]*int](), false},
+		{"string", hasPointers[string](), true},
+		{"[]int", hasPointers[[]int](), true},
+		{"*int", hasPointers[*int](), true},
+		{"any", hasPointers[any](), true},
+		{"[2]string", hasPointers[[2]string](), true},
+		{"person", hasPointers[person](), true},
+	}
+	for _, c := range cases {
+		if c.got != c.want {
+			t.Errorf("hasPointers[%s] = %v, want %v", c.name, c.got, c.want)
+		}
+	}
+}
+
+// churnHeap forces a few GC cycles and hands the freed memory back out, so
+// anything the collector failed to trace gets overwritten.
+func churnHeap() {
+	for i := 0; i < 3; i++ {
+		runtime.GC()
+		junk := make([][]byte, 0, 1024)
+		for j := 0; j < 1024; j++ {
+			junk = append(junk, []byte(strings.Repeat("x", 32)))
+		}
+		sink = junk
+	}
+	sink = nil
+	runtime.GC()
+}
+
+func newPerson(i int) person {
+	return person{
+		Name: fmt.Sprintf("person-%d", i),
+		Tags: []string{fmt.Sprintf("tag-%d", i)},
+		Meta: map[string]int{fmt.Sprintf("k%d", i): i},
+		Next: &person{Name: fmt.Sprintf("next-%d", i)},
+		Age:  i,
+	}
+}
+
+func checkPerson(t *testing.T, p *person, i int) {
+	t.Helper()
+	if p.Name != fmt.Sprintf("person-%d", i) ||
+		len(p.Tags) != 1 || p.Tags[0] != fmt.Sprintf("tag-%d", i) ||
+		p.Meta[fmt.Sprintf("k%d", i)] != i ||
+		p.Next == nil || p.Next.Name != fmt.Sprintf("next-%d", i) ||
+		p.Age != i {
+		t.Fatalf("object %d corrupted after GC: %+v", i, *p)
+	}
+}
+
+func testArenaKeepsPointersAlive(t *testing.T, newArena func(int) (Arena[person], error)) {
+	const n = 200
+	arena, err := newArena(n * int(unsafe.Sizeof(person{})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	objs := make([]*person, n)
+	for i := range objs {
+		if objs[i], err = arena.NewObject(newPerson(i)); err != nil {
+			t.Fatalf("NewObject %d: %v", i, err)
+		}
+	}
+	churnHeap()
+	for i, p := range objs {
+		checkPerson(t, p, i)
+	}
+}
+
+func TestMemoryArena_KeepsPointersAlive(t *testing.T) {
+	testArenaKeepsPointersAlive(t, NewMemoryArena[person])
+}
+
+func TestAtomicArena_KeepsPointersAlive(t *testing.T) {
+	testArenaKeepsPointersAlive(t, NewAtomicArena[person])
+}
+
+func TestConcurrentArena_KeepsPointersAlive(t *testing.T) {
+	testArenaKeepsPointersAlive(t, NewConcurrentArena[person])
+}
+
+func TestMemoryArena_AppendSliceKeepsPointersAlive(t *testing.T) {
+	arena, _ := NewMemoryArena[string](4096)
+	var s []string
+	var err error
+	for i := 0; i < 50; i++ {
+		if s, err = arena.AppendSlice(s, fmt.Sprintf("elem-%d", i)); err != nil {
+			t.Fatalf("AppendSlice: %v", err)
+		}
+	}
+	churnHeap()
+	for i, v := range s {
+		if v != fmt.Sprintf("elem-%d", i) {
+			t.Fatalf("elem %d corrupted: %q", i, v)
+		}
+	}
+}
+
+func TestMemoryArena_PointerSlots(t *testing.T) {
+	arena, _ := NewMemoryArena[person](1024)
+	size := int(unsafe.Sizeof(person{}))
+	p1, _ := arena.Allocate(1)
+	p2, _ := arena.NewObject(newPerson(1))
+	if got := uintptr(unsafe.Pointer(p2)) - uintptr(p1); got != uintptr(size) {
+		t.Fatalf("Allocate(1) reserved %d bytes, want one %d-byte slot", got, size)
+	}
+	arena.Reset()
+	p3, _ := arena.NewObject(person{})
+	if p3.Name != "" || p3.Meta != nil {
+		t.Fatalf("slot not cleared by Reset: %+v", *p3)
+	}
+}
@@ -0,0 +1,240 @@
+package memoryArena
+
+import (
+	"math/bits"
+	"unsafe"
+)
+
+// BuddyArena manages its buffer as power‑of‑two blocks of T‑sized units, the
+// classic buddy system.  An allocation takes the smallest free block that fits,
+// splitting larger ones in halves as needed; Free returns a block and merges it
+// with its free buddy, level by level, so the space can be handed out again at
+// any size.  AppendSlice gives the block it moves data out of back to the
+// arena, which makes repeated appends cost at most twice the final slice.
+//
+// Pointer‑bearing T get GC‑visible backing storage, as in NewMemoryArena.
+// Like MemoryArena it is NOT goroutine‑safe.
+type BuddyArena[T any] struct {
+	buffer   []byte         // backing storage (kept to satisfy GC & checkptr)
+	objects  []T            // typed backing storage when T holds pointers
+	base     unsafe.Pointer // first aligned byte inside the backing
+	unit     int            // bytes per unit: sizeof(T), at least 1
+	units    int            // usable capacity in units
+	pointers bool           // backing is GC‑scanned
+	free     [][]int        // free block offsets (in units) per order
+	blocks   map[int]buddyBlock
+	inUse    int // bytes in allocated blocks
+}
+
+// buddyBlock describes the block starting at a given unit offset.
+type buddyBlock struct {
+	order int8  // the block spans 1<<order units
+	free  bool  // on free[order]
+	pos   int32 // index in free[order] while free
+}
+
+// NewBuddyArena allocates a buddy arena with at least `size` bytes of usable
+// space.  Capacity that is not a power of two of units is split into several
+// top‑level blocks, largest first, so none of it is wasted.
+func NewBuddyArena[T any](size int) (*BuddyArena[T], error) {
+	if size <= 0 {
+		return nil, ErrInvalidSize
+	}
+	var dummy T
+	unit := max(int(unsafe.Sizeof(dummy)), 1)
+	buf, objs, base, usable := newBacking[T](size)
+	units := usable / unit
+	if units == 0 {
+		return nil, ErrInvalidSize
+	}
+	a := &BuddyArena[T]{
+		buffer:   buf,
+		objects:  objs,
+		base:     base,
+		unit:     unit,
+		units:    units,
+		pointers: objs != nil,
+		free:     make([][]int, bits.Len(uint(units))),
+	}
+	a.init()
+	return a, nil
+}
+
+// init lays out the top‑level blocks: one per set bit of units.
+func (a *BuddyArena[T]) init() {
+	a.blocks = make(map[int]buddyBlock)
+	for k := range a.free {
+		a.free[k] = a.free[k][:0]
+	}
+	off := 0
+	for k := len(a.free) - 1; k >= 0; k-- {
+		if a.units&(1<<k) != 0 {
+			a.push(off, k)
+			off += 1 << k
+		}
+	}
+	a.inUse = 0
+}
+
+func (a *BuddyArena[T]) push(off, k int) {
+	a.blocks[off] = buddyBlock{order: int8(k), free: true, pos: int32(len(a.free[k]))}
+	a.free[k] = append(a.free[k], off)
+}
+
+// unlink removes the free block at off from its free list in O(1).
+func (a *BuddyArena[T]) unlink(off int) {
+	b := a.blocks[off]
+	list := a.free[b.order]
+	last := list[len(list)-1]
+	list[b.pos] = last
+	moved := a.blocks[last]
+	moved.pos = b.pos
+	a.blocks[last] = moved
+	a.free[b.order] = list[:len(list)-1]
+	delete(a.blocks, off)
+}
+
+// orderFor returns the order of the smallest block holding sz bytes.
+func (a *BuddyArena[T]) orderFor(sz int) int {
+	n := (sz + a.unit - 1) / a.unit
+	return bits.Len(uint(n - 1))
+}
+
+// Allocate reserves a block of at least sz bytes, aligned for T.
+func (a *BuddyArena[T]) Allocate(sz int) (unsafe.Pointer, error) {
+	if sz <= 0 {
+		return nil, ErrInvalidSize
+	}
+	want := a.orderFor(sz)
+	k := want
+	for k < len(a.free) && len(a.free[k]) == 0 {
+		k++
+	}
+	if k >= len(a.free) {
+		return nil, ErrArenaFull
+	}
+	off := a.free[k][len(a.free[k])-1]
+	a.unlink(off)
+	// Split down to the requested order, freeing the upper halves.
+	for k > want {
+		k--
+		a.push(off+1<<k, k)
+	}
+	a.blocks[off] = buddyBlock{order: int8(k)}
+	a.inUse += a.unit << k
+	return unsafe.Add(a.base, off*a.unit), nil
+}
+
+// NewObject allocates space for T, copies obj into it, and returns *T.
+func (a *BuddyArena[T]) NewObject(obj T) (*T, error) {
+	ptr, err := a.Allocate(a.unit)
+	if err != nil {
+		return nil, err
+	}
+	r := (*T)(ptr)
+	*r = obj
+	return r, nil
+}
+
+// block returns the unit offset of the allocated block starting at ptr.
+func (a *BuddyArena[T]) block(ptr unsafe.Pointer) (int, bool) {
+	d := int(uintptr(ptr) - uintptr(a.base))
+	if ptr == nil || d < 0 || d >= a.units*a.unit || d%a.unit != 0 {
+		return 0, false
+	}
+	off := d / a.unit
+	b, ok := a.blocks[off]
+	return off, ok && !b.free
+}
+
+// Free zeroes the block starting at ptr and returns it to the arena, merging
+// it with its buddy for as long as the buddy is free too.  ptr must be a
+// pointer returned by Allocate or NewObject; anything else, including a block
+// that was already freed, returns ErrInvalidPointer.
+func (a *BuddyArena[T]) Free(ptr unsafe.Pointer) error {
+	off, ok := a.block(ptr)
+	if !ok {
+		return ErrInvalidPointer
+	}
+	k := int(a.blocks[off].order)
+	clearRange[T](a.base, off*a.unit, (off+1<<k)*a.unit, a.pointers)
+	a.inUse -= a.unit << k
+	delete(a.blocks, off)
+	for k+1 < len(a.free) {
+		buddy := off ^ 1<<k
+		b, ok := a.blocks[buddy]
+		if !ok || !b.free || int(b.order) != k {
+			break
+		}
+		a.unlink(buddy)
+		off = min(off, buddy)
+		k++
+	}
+	a.push(off, k)
+	return nil
+}
+
+// AppendSlice appends elems to slice.  When slice runs out of capacity the
+// data moves to a block of the next power‑of‑two size, and if slice started
+// a block of this arena that block is freed – slices sharing the old backing
+// array must not be used afterwards.
+func (a *BuddyArena[T]) AppendSlice(slice []T, elems ...T) ([]T, error) {
+	if len(elems) == 0 {
+		return slice, nil
+	}
+	need := len(slice) + len(elems)
+	if need <= cap(slice) {
+		return append(slice, elems...), nil
+	}
+	newCap := nextPow2(need)
+	ptr, err := a.Allocate(newCap * a.unit)
+	if err != nil {
+		return nil, err
+	}
+	newArr := unsafe.Slice((*T)(ptr), newCap)
+	n := copy(newArr, slice)
+	copy(newArr[n:], elems)
+	if cap(slice) > 0 {
+		if old := unsafe.Pointer(unsafe.SliceData(slice)); old != ptr {
+			if _, ok := a.block(old); ok {
+				a.Free(old)
+			}
+		}
+	}
+	return newArr[:need], nil
+}
+
+// Reset zeroes every allocated block and makes the whole arena free again.
+func (a *BuddyArena[T]) Reset() {
+	for off, b := range a.blocks {
+		if !b.free {
+			clearRange[T](a.base, off*a.unit, (off+1<<b.order)*a.unit, a.pointers)
+		}
+	}
+	a.init()
+}
+
+// Offset returns the number of bytes in allocated blocks, including the
+// rounding of each request up to its power‑of‑two block.
+func (a *BuddyArena[T]) Offset() int {
+	return a.inUse
+}
+
+func (a *BuddyArena[T]) Base() unsafe.Pointer {
+	return a.base
+}
+
+// Capacity returns the usable size of the arena in bytes.
+func (a *BuddyArena[T]) Capacity() int {
+	return a.units * a.unit
+}
+
+// FreeBlocks returns the number of free blocks of 1<<order units for every
+// order, smallest first – a quick view of fragmentation.
+func (a *BuddyArena[T]) FreeBlocks() []int {
+	out := make([]int, len(a.free))
+	for k := range a.free {
+		out[k] = len(a.free[k])
+	}
+	return out
+}
@@ -0,0 +1,147 @@
+package memoryArena
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestNewBuddyArena_Layout(t *testing.T) {
+	if _, err := NewBuddyArena[int](0); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+	// 13 units = 8 + 4 + 1: three top‑level blocks, nothing wasted.
+	a, _ := NewBuddyArena[int64](13 * 8)
+	if a.Capacity() != 13*8 {
+		t.Fatalf("capacity %d", a.Capacity())
+	}
+	if got := a.FreeBlocks(); got[0] != 1 || got[1] != 0 || got[2] != 1 || got[3] != 1 {
+		t.Fatalf("free blocks %v, want [1 0 1 1]", got)
+	}
+	for i := 0; i < 13; i++ {
+		if _, err := a.NewObject(int64(i)); err != nil {
+			t.Fatalf("NewObject %d: %v", i, err)
+		}
+	}
+	if _, err := a.NewObject(0); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+}
+
+func TestBuddyArena_SplitAndCoalesce(t *testing.T) {
+	a, _ := NewBuddyArena[int64](64 * 8)
+	p, _ := a.Allocate(3 * 8) // rounds to a 4‑unit block
+	q, _ := a.Allocate(8)
+	if a.Offset() != 5*8 {
+		t.Fatalf("Offset %d, want %d", a.Offset(), 5*8)
+	}
+	if _, err := a.Allocate(64 * 8); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull while split, got %v", err)
+	}
+	*(*int64)(q) = 42
+	if err := a.Free(q); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Free(q); err != ErrInvalidPointer {
+		t.Fatalf("double free: want ErrInvalidPointer, got %v", err)
+	}
+	if err := a.Free(p); err != nil {
+		t.Fatal(err)
+	}
+	if a.Offset() != 0 {
+		t.Fatalf("Offset %d after freeing everything", a.Offset())
+	}
+	// Buddies merged all the way back into one block.
+	if fb := a.FreeBlocks(); fb[6] != 1 {
+		t.Fatalf("blocks not coalesced: %v", fb)
+	}
+	r, err := a.Allocate(64 * 8)
+	if err != nil {
+		t.Fatalf("whole arena after coalescing: %v", err)
+	}
+	for i, v := range unsafe.Slice((*int64)(r), 64) {
+		if v != 0 {
+			t.Fatalf("unit %d not zeroed after Free: %d", i, v)
+		}
+	}
+}
+
+func TestBuddyArena_InvalidFree(t *testing.T) {
+	a, _ := NewBuddyArena[int64](256)
+	p, _ := a.Allocate(32)
+	var x int64
+	for name, ptr := range map[string]unsafe.Pointer{
+		"nil":      nil,
+		"foreign":  unsafe.Pointer(&x),
+		"interior": unsafe.Add(p, 8),
+		"free":     unsafe.Add(p, 32),
+	} {
+		if err := a.Free(ptr); err != ErrInvalidPointer {
+			t.Errorf("%s: want ErrInvalidPointer, got %v", name, err)
+		}
+	}
+}
+
+func TestBuddyArena_AppendSliceFreesOldBlock(t *testing.T) {
+	a, _ := NewBuddyArena[int](128 * 8)
+	var s []int
+	var err error
+	for i := 0; i < 33; i++ {
+		if s, err = a.AppendSlice(s, i); err != nil {
+			t.Fatalf("append %d: %v", i, err)
+		}
+	}
+	for i, v := range s {
+		if v != i {
+			t.Fatalf("s[%d] = %d", i, v)
+		}
+	}
+	// Only the final 64‑element block is live and the outgrown 8, 16 and 32
+	// element blocks have merged back into its free buddy.
+	if a.Offset() != 64*8 || cap(s) != 64 {
+		t.Fatalf("Offset %d cap %d, want only the final block live", a.Offset(), cap(s))
+	}
+	if fb := a.FreeBlocks(); fb[6] != 1 || fb[3]+fb[4]+fb[5] != 0 {
+		t.Fatalf("free blocks %v, want a single 64‑unit block", fb)
+	}
+}
+
+func TestBuddyArena_AppendSliceKeepsForeignBacking(t *testing.T) {
+	a, _ := NewBuddyArena[int](64 * 8)
+	heap := []int{1, 2}
+	s, err := a.AppendSlice(heap, 3)
+	if err != nil || len(s) != 3 || heap[0] != 1 {
+		t.Fatalf("append to heap slice: %v %v", s, err)
+	}
+}
+
+func TestBuddyArena_Reset(t *testing.T) {
+	a, _ := NewBuddyArena[int64](16 * 8)
+	for i := 0; i < 16; i++ {
+		a.NewObject(-1)
+	}
+	a.Reset()
+	if a.Offset() != 0 || a.FreeBlocks()[4] != 1 {
+		t.Fatalf("Reset did not restore the free lists: %v", a.FreeBlocks())
+	}
+	p, _ := a.Allocate(16 * 8)
+	for i, v := range unsafe.Slice((*int64)(p), 16) {
+		if v != 0 {
+			t.Fatalf("unit %d not zeroed by Reset", i)
+		}
+	}
+}
+
+func TestBuddyArena_KeepsPointersAlive(t *testing.T) {
+	testArenaKeepsPointersAlive(t, func(size int) (Arena[person], error) {
+		return NewBuddyArena[person](size)
+	})
+}
+
+func BenchmarkBuddyArena_AllocFree(b *testing.B) {
+	a, _ := NewBuddyArena[int64](1 << 20)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		p, _ := a.Allocate(8 << (i & 7))
+		a.Free(p)
+	}
+}
@@ -0,0 +1,206 @@
+package memoryArena
+
+import "unsafe"
+
+// GrowthMode selects how a ChunkedArena sizes the chunks it adds once the
+// current one is exhausted.
+type GrowthMode int
+
+const (
+	// GrowFixed adds chunks of the initial chunk size.
+	GrowFixed GrowthMode = iota
+	// GrowDoubling makes every new chunk twice as large as the previous one.
+	GrowDoubling
+	// GrowCapped doubles like GrowDoubling but never exceeds MaxChunkSize;
+	// requests larger than that fail with ErrArenaFull.
+	GrowCapped
+)
+
+// GrowthPolicy configures how a ChunkedArena grows and what Reset keeps.
+type GrowthPolicy struct {
+	Mode           GrowthMode
+	MaxChunkSize   int  // upper bound on a chunk's size for GrowCapped
+	MaxTotal       int  // upper bound on the summed capacity; 0 means unlimited
+	ReleaseOnReset bool // Reset drops every chunk but the first
+}
+
+// ChunkedArena is a bump‑allocator that links additional MemoryArena chunks
+// instead of returning ErrArenaFull.  Objects never move: a full chunk is left
+// as is and allocation continues in the next one.
+// Like MemoryArena it is NOT goroutine‑safe.
+type ChunkedArena[T any] struct {
+	chunks    []*MemoryArena[T]
+	cur       int // index of the chunk allocations are served from
+	chunkSize int // size of the first chunk
+	total     int // capacity summed over all chunks
+	elemSize  int
+	policy    GrowthPolicy
+}
+
+// NewChunkedArena creates an arena whose first chunk holds chunkSize bytes;
+// further chunks are added according to policy.
+func NewChunkedArena[T any](chunkSize int, policy GrowthPolicy) (*ChunkedArena[T], error) {
+	if chunkSize <= 0 {
+		return nil, ErrInvalidSize
+	}
+	if policy.Mode == GrowCapped && policy.MaxChunkSize < chunkSize {
+		return nil, ErrInvalidSize
+	}
+	if policy.MaxTotal < 0 || (policy.MaxTotal > 0 && policy.MaxTotal < chunkSize) {
+		return nil, ErrInvalidSize
+	}
+	first, err := newMemoryArena[T](chunkSize)
+	if err != nil {
+		return nil, err
+	}
+	var dummy T
+	return &ChunkedArena[T]{
+		chunks:    []*MemoryArena[T]{first},
+		chunkSize: chunkSize,
+		total:     first.size,
+		elemSize:  int(unsafe.Sizeof(dummy)),
+		policy:    policy,
+	}, nil
+}
+
+// Allocate reserves sz bytes (aligned for T), adding a chunk if needed.
+func (c *ChunkedArena[T]) Allocate(sz int) (unsafe.Pointer, error) {
+	if sz <= 0 {
+		return nil, ErrInvalidSize
+	}
+	for fresh := false; ; {
+		p, err := c.chunks[c.cur].Allocate(sz)
+		// A chunk added just for this request gets exactly one attempt.
+		if err != ErrArenaFull || fresh {
+			return p, err
+		}
+		var ok bool
+		if fresh, ok = c.advance(sz); !ok {
+			return nil, ErrArenaFull
+		}
+	}
+}
+
+// NewObject allocates space for T, copies obj into it, and returns *T.
+func (c *ChunkedArena[T]) NewObject(obj T) (*T, error) {
+	ptr, err := c.Allocate(c.elemSize)
+	if err != nil {
+		return nil, err
+	}
+	p := (*T)(ptr)
+	*p = obj
+	return p, nil
+}
+
+// AppendSlice appends elems to slice.  When the current chunk cannot hold the
+// grown slice, it is copied into a chunk large enough for it.
+func (c *ChunkedArena[T]) AppendSlice(slice []T, elems ...T) ([]T, error) {
+	out, err := c.chunks[c.cur].AppendSlice(slice, elems...)
+	if err != ErrArenaFull {
+		return out, err
+	}
+	n := len(slice) + len(elems)
+	need := nextPow2(n) * c.elemSize
+	if c.policy.Mode == GrowCapped {
+		// A capped chunk may still fit the slice without the spare room.
+		need = max(min(need, c.policy.MaxChunkSize), n*c.elemSize)
+	}
+	for {
+		fresh, ok := c.advance(need)
+		if !ok {
+			return slice, ErrArenaFull
+		}
+		out, err = c.chunks[c.cur].AppendSlice(slice, elems...)
+		if err != ErrArenaFull || fresh {
+			return out, err
+		}
+	}
+}
+
+// advance moves allocation to the next chunk that can hold min bytes, reusing
+// chunks retained by Reset before adding a new one; fresh reports whether the
+// chunk was just added.  ok is false when the policy's MaxChunkSize or
+// MaxTotal leaves no room for such a chunk or its memory cannot be obtained.
+func (c *ChunkedArena[T]) advance(min int) (fresh, ok bool) {
+	if c.policy.Mode == GrowCapped && min > c.policy.MaxChunkSize {
+		return false, false
+	}
+	for c.cur+1 < len(c.chunks) {
+		c.cur++
+		if c.chunks[c.cur].size >= min {
+			return false, true
+		}
+	}
+	size := c.nextChunkSize()
+	if size < min {
+		size = min
+	}
+	if c.policy.MaxTotal > 0 && c.total+size > c.policy.MaxTotal {
+		size = c.policy.MaxTotal - c.total
+		if size < min {
+			return false, false
+		}
+	}
+	chunk, err := newMemoryArena[T](size)
+	if err != nil {
+		return false, false
+	}
+	c.chunks = append(c.chunks, chunk)
+	c.total += chunk.size
+	c.cur = len(c.chunks) - 1
+	return true, true
+}
+
+func (c *ChunkedArena[T]) nextChunkSize() int {
+	last := c.chunks[len(c.chunks)-1].size
+	switch c.policy.Mode {
+	case GrowDoubling:
+		return last * 2
+	case GrowCapped:
+		if last*2 > c.policy.MaxChunkSize {
+			return c.policy.MaxChunkSize
+		}
+		return last * 2
+	default:
+		return c.chunkSize
+	}
+}
+
+// Reset zeroes every chunk used since the last Reset and rewinds to the first
+// chunk.  Chunks are kept for reuse unless the policy sets ReleaseOnReset.
+func (c *ChunkedArena[T]) Reset() {
+	for i := 0; i <= c.cur; i++ {
+		c.chunks[i].Reset()
+	}
+	c.cur = 0
+	if c.policy.ReleaseOnReset && len(c.chunks) > 1 {
+		clear(c.chunks[1:])
+		c.chunks = c.chunks[:1]
+		c.total = c.chunks[0].size
+	}
+}
+
+// Offset returns the number of bytes in use across all chunks, including
+// alignment padding and the unused tails of chunks that were left behind.
+func (c *ChunkedArena[T]) Offset() int {
+	used := 0
+	for i := 0; i < c.cur; i++ {
+		used += c.chunks[i].size
+	}
+	return used + c.chunks[c.cur].offset
+}
+
+// Base returns the start of the first chunk.
+func (c *ChunkedArena[T]) Base() unsafe.Pointer {
+	return c.chunks[0].base
+}
+
+// Capacity returns the total capacity in bytes across all chunks.
+func (c *ChunkedArena[T]) Capacity() int {
+	return c.total
+}
+
+// Chunks returns the number of chunks currently held by the arena.
+func (c *ChunkedArena[T]) Chunks() int {
+	return len(c.chunks)
+}
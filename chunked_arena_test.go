@@ -0,0 +1,183 @@
+package memoryArena
+
+import (
+	"testing"
+	"unsafe"
+)
+
+var _ Arena[int] = (*ChunkedArena[int])(nil)
+
+func TestNewChunkedArena_Errors(t *testing.T) {
+	if _, err := NewChunkedArena[int](0, GrowthPolicy{}); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+	if _, err := NewChunkedArena[int](64, GrowthPolicy{Mode: GrowCapped, MaxChunkSize: 32}); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize for cap below chunk size, got %v", err)
+	}
+	if _, err := NewChunkedArena[int](64, GrowthPolicy{MaxTotal: 32}); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize for MaxTotal below chunk size, got %v", err)
+	}
+}
+
+func TestChunkedArena_GrowsInsteadOfFull(t *testing.T) {
+	c, _ := NewChunkedArena[int](64, GrowthPolicy{})
+	ptrs := make([]*int, 100)
+	for i := range ptrs {
+		p, err := c.NewObject(i)
+		if err != nil {
+			t.Fatalf("NewObject %d: %v", i, err)
+		}
+		ptrs[i] = p
+	}
+	for i, p := range ptrs {
+		if *p != i {
+			t.Fatalf("object %d moved or corrupted: %d", i, *p)
+		}
+	}
+	if c.Chunks() < 2 {
+		t.Fatalf("expected several chunks, got %d", c.Chunks())
+	}
+	if c.Capacity() != c.Chunks()*64 {
+		t.Fatalf("capacity %d, want %d", c.Capacity(), c.Chunks()*64)
+	}
+}
+
+func TestChunkedArena_GrowthModes(t *testing.T) {
+	cases := []struct {
+		name   string
+		policy GrowthPolicy
+		want   []int
+	}{
+		{"fixed", GrowthPolicy{Mode: GrowFixed}, []int{64, 64, 64, 64}},
+		{"doubling", GrowthPolicy{Mode: GrowDoubling}, []int{64, 128, 256, 512}},
+		{"capped", GrowthPolicy{Mode: GrowCapped, MaxChunkSize: 200}, []int{64, 128, 200, 200}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c, _ := NewChunkedArena[byte](64, tc.policy)
+			for c.Chunks() < len(tc.want) {
+				if _, err := c.Allocate(8); err != nil {
+					t.Fatalf("Allocate: %v", err)
+				}
+			}
+			for i, want := range tc.want {
+				if got := c.chunks[i].size; got != want {
+					t.Fatalf("chunk %d size %d, want %d", i, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestChunkedArena_MaxTotal(t *testing.T) {
+	c, _ := NewChunkedArena[byte](64, GrowthPolicy{MaxTotal: 160})
+	total := 0
+	for {
+		if _, err := c.Allocate(16); err != nil {
+			if err != ErrArenaFull {
+				t.Fatalf("want ErrArenaFull, got %v", err)
+			}
+			break
+		}
+		total += 16
+	}
+	if total != 160 || c.Capacity() != 160 {
+		t.Fatalf("allocated %d of capacity %d, want 160", total, c.Capacity())
+	}
+}
+
+func TestChunkedArena_OversizedAllocation(t *testing.T) {
+	c, _ := NewChunkedArena[byte](64, GrowthPolicy{})
+	p, err := c.Allocate(1000)
+	if err != nil {
+		t.Fatalf("Allocate: %v", err)
+	}
+	b := unsafe.Slice((*byte)(p), 1000)
+	b[999] = 1
+	if c.Capacity() < 1064 {
+		t.Fatalf("capacity %d too small", c.Capacity())
+	}
+}
+
+func TestChunkedArena_CappedRejectsOversized(t *testing.T) {
+	c, _ := NewChunkedArena[byte](64, GrowthPolicy{Mode: GrowCapped, MaxChunkSize: 128})
+	if _, err := c.Allocate(1000); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+	if _, err := c.Allocate(128); err != nil {
+		t.Fatalf("Allocate(MaxChunkSize): %v", err)
+	}
+	for _, ch := range c.chunks {
+		if ch.size > 128 {
+			t.Fatalf("chunk of %d bytes exceeds MaxChunkSize", ch.size)
+		}
+	}
+
+	ci, _ := NewChunkedArena[int](64, GrowthPolicy{Mode: GrowCapped, MaxChunkSize: 100})
+	s, err := ci.AppendSlice(nil, make([]int, 9)...) // 72 bytes, next power of two 128
+	if err != nil || len(s) != 9 {
+		t.Fatalf("AppendSlice within MaxChunkSize: %v", err)
+	}
+	if _, err := ci.AppendSlice(s, make([]int, 4)...); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+}
+
+func TestChunkedArena_AppendSliceAcrossChunks(t *testing.T) {
+	c, _ := NewChunkedArena[int](64, GrowthPolicy{Mode: GrowDoubling})
+	var s []int
+	var err error
+	for i := 0; i < 100; i++ {
+		if s, err = c.AppendSlice(s, i); err != nil {
+			t.Fatalf("AppendSlice %d: %v", i, err)
+		}
+	}
+	for i, v := range s {
+		if v != i {
+			t.Fatalf("s[%d] = %d", i, v)
+		}
+	}
+}
+
+func TestChunkedArena_ResetRetainsChunks(t *testing.T) {
+	c, _ := NewChunkedArena[int](64, GrowthPolicy{})
+	for i := 0; i < 40; i++ {
+		c.NewObject(i)
+	}
+	chunks := c.Chunks()
+	c.Reset()
+	if c.Offset() != 0 || c.Chunks() != chunks {
+		t.Fatalf("after Reset offset=%d chunks=%d, want 0 and %d", c.Offset(), c.Chunks(), chunks)
+	}
+	for i := 0; i < 40; i++ {
+		p, _ := c.NewObject(0)
+		if *p != 0 {
+			t.Fatalf("reused memory not zeroed")
+		}
+	}
+	if c.Chunks() != chunks {
+		t.Fatalf("retained chunks not reused: %d, want %d", c.Chunks(), chunks)
+	}
+}
+
+func TestChunkedArena_ResetReleasesChunks(t *testing.T) {
+	c, _ := NewChunkedArena[int](64, GrowthPolicy{Mode: GrowDoubling, ReleaseOnReset: true})
+	for i := 0; i < 100; i++ {
+		c.NewObject(i)
+	}
+	c.Reset()
+	if c.Chunks() != 1 || c.Capacity() != 64 {
+		t.Fatalf("after Reset chunks=%d capacity=%d, want 1 and 64", c.Chunks(), c.Capacity())
+	}
+}
+
+func BenchmarkChunkedArena_NewObject(b *testing.B) {
+	c, _ := NewChunkedArena[int](1<<16, GrowthPolicy{Mode: GrowDoubling})
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		c.NewObject(i)
+		if i&0xFFFFF == 0 {
+			c.Reset()
+		}
+	}
+}
@@ -7,11 +7,11 @@ import (
 
 type ConcurrentArena[T any] struct {
 	mu    sync.Mutex
-	arena Arena[T]
+	arena *MemoryArena[T]
 }
 
 func NewConcurrentArena[T any](size int) (Arena[T], error) {
-	a, err := NewMemoryArena[T](size)
+	a, err := newMemoryArena[T](size)
 	if err != nil {
 		return nil, err
 	}
@@ -45,10 +45,78 @@ func (c *ConcurrentArena[T]) Reset() {
 	c.mu.Unlock()
 }
 
+// Resize changes the capacity of the underlying MemoryArena, see
+// MemoryArena.Resize.
+func (c *ConcurrentArena[T]) Resize(newSize int) error {
+	c.mu.Lock()
+	err := c.arena.Resize(newSize)
+	c.mu.Unlock()
+	return err
+}
+
+// Mark returns a checkpoint of the current allocation offset.
+func (c *ConcurrentArena[T]) Mark() Mark {
+	c.mu.Lock()
+	m := c.arena.Mark()
+	c.mu.Unlock()
+	return m
+}
+
+// Rewind releases everything allocated after m, see MemoryArena.Rewind.
+// Allocations made by other goroutines after m are released too.
+func (c *ConcurrentArena[T]) Rewind(m Mark) error {
+	c.mu.Lock()
+	err := c.arena.Rewind(m)
+	c.mu.Unlock()
+	return err
+}
+
+// SubArena reserves n bytes and returns a single‑goroutine MemoryArena
+// confined to them, see MemoryArena.SubArena.  Handlers can carve their own
+// sub‑arena concurrently and reset it without taking the lock again.
+func (c *ConcurrentArena[T]) SubArena(n int) (Arena[T], error) {
+	c.mu.Lock()
+	sub, err := c.arena.SubArena(n)
+	c.mu.Unlock()
+	return sub, err
+}
+
+// Verify checks the guard bytes of every live allocation; it only finds
+// anything in builds with the memoryarena_debug tag, see MemoryArena.Verify.
+func (c *ConcurrentArena[T]) Verify() error {
+	c.mu.Lock()
+	err := c.arena.Verify()
+	c.mu.Unlock()
+	return err
+}
+
 func (c *ConcurrentArena[T]) Offset() int {
-	return c.arena.Offset()
+	c.mu.Lock()
+	off := c.arena.Offset()
+	c.mu.Unlock()
+	return off
 }
 
+// Base returns the start of the current region, which Resize may replace.
 func (ca *ConcurrentArena[T]) Base() unsafe.Pointer {
-	return ca.arena.Base()
+	ca.mu.Lock()
+	base := ca.arena.Base()
+	ca.mu.Unlock()
+	return base
+}
+
+// Stats returns a snapshot of the arena's allocation counters.
+func (c *ConcurrentArena[T]) Stats() Stats {
+	c.mu.Lock()
+	s := c.arena.Stats()
+	c.mu.Unlock()
+	return s
+}
+
+// Generation returns the number of times the arena has been reset.
+func (c *ConcurrentArena[T]) Generation() uint64 {
+	c.mu.Lock()
+	g := c.arena.gen
+	c.mu.Unlock()
+	return g
 }
@@ -190,3 +190,80 @@ func BenchmarkMemoryArenaNewObject(b *testing.B) {
 		a.NewObject(i)
 	}
 }
+
+func TestConcurrentArena_Resize(t *testing.T) {
+	ca, _ := NewConcurrentArena[int](64)
+	r := ca.(Resizer)
+	for i := 0; i < 8; i++ {
+		ca.NewObject(i)
+	}
+	if err := r.Resize(8); err != ErrNewSizeTooSmall {
+		t.Fatalf("want ErrNewSizeTooSmall, got %v", err)
+	}
+	if err := r.Resize(1 << 10); err != nil {
+		t.Fatalf("grow: %v", err)
+	}
+	if _, err := ca.NewObject(9); err != nil {
+		t.Fatalf("NewObject after grow: %v", err)
+	}
+}
+
+func TestConcurrentArena_ResizeWhileReading(t *testing.T) {
+	ca, _ := NewConcurrentArena[int](64)
+	r := ca.(Resizer)
+	ca.NewObject(1)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			_ = ca.Base()
+			_ = ca.Offset()
+		}
+	}()
+	for i := 1; i <= 100; i++ {
+		if err := r.Resize(64 + i*64); err != nil {
+			t.Fatalf("Resize: %v", err)
+		}
+	}
+	<-done
+}
+
+func TestConcurrentArena_MarkRewind(t *testing.T) {
+	ca, _ := NewConcurrentArena[int](256)
+	r := ca.(Rewinder)
+	m := r.Mark()
+	ca.NewObject(1)
+	if err := r.Rewind(m); err != nil || ca.Offset() != 0 {
+		t.Fatalf("Rewind: err=%v offset=%d", err, ca.Offset())
+	}
+	other, _ := NewConcurrentArena[int](256)
+	if err := r.Rewind(other.(Rewinder).Mark()); err != ErrInvalidMark {
+		t.Fatalf("foreign mark: want ErrInvalidMark, got %v", err)
+	}
+}
+
+func TestConcurrentArena_SubArena(t *testing.T) {
+	ca, _ := NewConcurrentArena[int](1 << 16)
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(id int) {
+			defer wg.Done()
+			sub, err := ca.(*ConcurrentArena[int]).SubArena(1024)
+			if err != nil {
+				t.Errorf("SubArena: %v", err)
+				return
+			}
+			for round := 0; round < 10; round++ {
+				for i := 0; i < 128; i++ {
+					if _, err := sub.NewObject(id); err != nil {
+						t.Errorf("sub NewObject: %v", err)
+						return
+					}
+				}
+				sub.Reset()
+			}
+		}(w)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,84 @@
+package memoryArena
+
+import (
+	"context"
+	"sync"
+)
+
+// ctxKey is the context key for an Arena[T]; being generic, arenas of
+// different element types can be attached to the same context.
+type ctxKey[T any] struct{}
+
+// WithArena returns a copy of ctx that carries a, so code deep in a request
+// can allocate from it without threading the arena through every signature.
+func WithArena[T any](ctx context.Context, a Arena[T]) context.Context {
+	return context.WithValue(ctx, ctxKey[T]{}, a)
+}
+
+// FromContext returns the Arena[T] attached to ctx by WithArena, if any.
+func FromContext[T any](ctx context.Context) (Arena[T], bool) {
+	a, ok := ctx.Value(ctxKey[T]{}).(Arena[T])
+	return a, ok
+}
+
+// ArenaScope hands out request‑scoped arenas.  Arenas are taken from a
+// sync.Pool at the start of a request and Reset and returned to it when the
+// request completes, so steady traffic reuses a handful of arenas instead of
+// allocating one per request.
+type ArenaScope[T any] struct {
+	pool     sync.Pool
+	newArena func() (Arena[T], error)
+}
+
+// NewArenaScope returns a scope whose arenas have `size` bytes of capacity.
+// concurrent selects NewConcurrentArena over NewMemoryArena, for requests
+// that allocate from several goroutines.
+func NewArenaScope[T any](size int, concurrent bool) (*ArenaScope[T], error) {
+	if size <= 0 {
+		return nil, ErrInvalidSize
+	}
+	newArena := NewMemoryArena[T]
+	if concurrent {
+		newArena = NewConcurrentArena[T]
+	}
+	return &ArenaScope[T]{newArena: func() (Arena[T], error) { return newArena(size) }}, nil
+}
+
+// Acquire returns an empty arena from the pool, creating one if it is empty.
+func (s *ArenaScope[T]) Acquire() (Arena[T], error) {
+	if a, ok := s.pool.Get().(Arena[T]); ok {
+		return a, nil
+	}
+	return s.newArena()
+}
+
+// Release resets a and returns it to the pool.  Nothing allocated from a may
+// be used afterwards.
+func (s *ArenaScope[T]) Release(a Arena[T]) {
+	a.Reset()
+	s.pool.Put(a)
+}
+
+// Begin acquires an arena and attaches it to ctx.  The returned end function
+// releases the arena and must be called when the request completes; calls
+// after the first, from any goroutine, have no effect.
+func (s *ArenaScope[T]) Begin(ctx context.Context) (context.Context, func(), error) {
+	a, err := s.Acquire()
+	if err != nil {
+		return ctx, func() {}, err
+	}
+	var once sync.Once
+	end := func() { once.Do(func() { s.Release(a) }) }
+	return WithArena(ctx, a), end, nil
+}
+
+// Do runs f with an arena attached to its context and releases the arena when
+// f returns or panics.
+func (s *ArenaScope[T]) Do(ctx context.Context, f func(ctx context.Context) error) error {
+	ctx, end, err := s.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer end()
+	return f(ctx)
+}
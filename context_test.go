@@ -0,0 +1,142 @@
+package memoryArena
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestContext_WithArena(t *testing.T) {
+	ints, _ := NewMemoryArena[int](64)
+	points, _ := NewMemoryArena[point](64)
+	ctx := WithArena(WithArena(context.Background(), ints), points)
+
+	if a, ok := FromContext[int](ctx); !ok || a != ints {
+		t.Fatalf("Arena[int] not found")
+	}
+	if a, ok := FromContext[point](ctx); !ok || a != points {
+		t.Fatalf("Arena[point] not found")
+	}
+	if _, ok := FromContext[string](ctx); ok {
+		t.Fatalf("found an Arena[string] that was never attached")
+	}
+}
+
+// handle stands in for library code that allocates from the request arena.
+func handle(ctx context.Context, v int) (*int, error) {
+	a, ok := FromContext[int](ctx)
+	if !ok {
+		return nil, errors.New("no arena")
+	}
+	return a.NewObject(v)
+}
+
+func TestArenaScope_DoReusesArenas(t *testing.T) {
+	if _, err := NewArenaScope[int](0, false); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+	s, _ := NewArenaScope[int](1024, false)
+	var first Arena[int]
+	err := s.Do(context.Background(), func(ctx context.Context) error {
+		first, _ = FromContext[int](ctx)
+		_, err := handle(ctx, 42)
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.Offset() != 0 {
+		t.Fatalf("arena not Reset on release: offset %d", first.Offset())
+	}
+	// The pool may drop items at any GC, so only check reuse is possible.
+	s.Do(context.Background(), func(ctx context.Context) error {
+		a, _ := FromContext[int](ctx)
+		if a.Offset() != 0 {
+			t.Fatalf("acquired a dirty arena")
+		}
+		return nil
+	})
+}
+
+func TestArenaScope_ReleasedOnPanic(t *testing.T) {
+	s, _ := NewArenaScope[int](1024, false)
+	var used Arena[int]
+	func() {
+		defer func() { recover() }()
+		s.Do(context.Background(), func(ctx context.Context) error {
+			used, _ = FromContext[int](ctx)
+			handle(ctx, 1)
+			panic("handler failed")
+		})
+	}()
+	if used == nil || used.Offset() != 0 {
+		t.Fatalf("arena not released after panic")
+	}
+}
+
+func TestArenaScope_BeginEnd(t *testing.T) {
+	s, _ := NewArenaScope[int](1024, true)
+	ctx, end, err := s.Begin(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if p, err := handle(ctx, i); err != nil || *p != i {
+				t.Errorf("handle: %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+	a, _ := FromContext[int](ctx)
+	if a.Offset() != 8*8 {
+		t.Fatalf("Offset %d, want %d", a.Offset(), 64)
+	}
+	end()
+	end() // second call is a no‑op
+	if a.Offset() != 0 {
+		t.Fatalf("end did not reset the arena")
+	}
+}
+
+// end racing with itself – say a timeout and a deferred call – must return
+// the arena to the pool only once.
+func TestArenaScope_EndConcurrent(t *testing.T) {
+	s, _ := NewArenaScope[int](1024, false)
+	_, end, _ := s.Begin(context.Background())
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			end()
+		}()
+	}
+	wg.Wait()
+	a1, _ := s.Acquire()
+	a2, _ := s.Acquire()
+	if a1 == a2 {
+		t.Fatalf("arena pooled twice: two requests share it")
+	}
+}
+
+func BenchmarkArenaScope_Do(b *testing.B) {
+	s, _ := NewArenaScope[int](4096, false)
+	ctx := context.Background()
+	f := func(ctx context.Context) error {
+		for i := 0; i < 16; i++ {
+			if _, err := handle(ctx, i); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		s.Do(ctx, f)
+	}
+}
@@ -0,0 +1,220 @@
+package memoryArena
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Debug support, compiled in with `-tags memoryarena_debug`.
+//
+// Every block handed out by MemoryArena.Allocate is surrounded by canary bytes
+// and recorded, Reset and Rewind fill released memory with a poison pattern
+// instead of zeroing it, and Verify walks the recorded blocks looking for
+// clobbered canaries.  Overruns through unsafe.Slice views, writes past len
+// into cap, and reads of memory after Reset all become visible.
+//
+// The canaries live outside the usable capacity: instrumented arenas get a
+// larger backing (see guardOverhead) and keep two offsets, the logical one a
+// normal build would have – reported by Offset and Stats and checked against
+// the capacity – and the physical one, phys, past the last trailing guard,
+// which stays below limit.
+//
+// Arenas whose T holds pointers keep the regular layout (canaries would break
+// the GC's view of the slots), and AtomicArena is not instrumented.  In normal
+// builds Verify always returns nil.
+
+const (
+	guardSize  = 8    // canary bytes on each side of a block
+	canaryByte = 0xFD // fill for guard bytes
+	poisonByte = 0xDD // fill for released memory
+)
+
+// guard records one instrumented allocation.
+type guard struct {
+	start int // first byte of the leading canary (includes alignment padding)
+	off   int // first byte handed to the caller
+	size  int // bytes handed to the caller
+	loff  int // logical offset of the block
+	lsize int // logical size; smaller than size for a sub‑arena's reservation
+}
+
+// OverrunError reports a clobbered canary found by Verify.
+type OverrunError struct {
+	Offset int // offset of the allocation whose guard was hit
+	Size   int // size of that allocation
+	At     int // offset of the first corrupted guard byte
+}
+
+func (e *OverrunError) Error() string {
+	return fmt.Sprintf("memory arena: allocation at offset %d (size %d) overrun at offset %d",
+		e.Offset, e.Size, e.At)
+}
+
+// instrumented reports whether allocations in a carry guards.
+func (a *MemoryArena[T]) instrumented() bool {
+	return debugArena && !a.pointers
+}
+
+// guardOverhead returns the bytes an instrumented arena needs past size
+// usable bytes: canaries and alignment padding for a block at every aligned
+// offset below size.  It is 0 in normal builds.
+func guardOverhead[T any](size int) int {
+	if !debugArena || hasPointers[T]() {
+		return 0
+	}
+	var dummy T
+	align := int(unsafe.Alignof(dummy))
+	return (size + align - 1) / align * (2*guardSize + align - 1)
+}
+
+// physNeed returns the physical bytes still needed to serve the capacity
+// left above logical offset from.
+func (a *MemoryArena[T]) physNeed(from int) int {
+	from = min(from, a.size)
+	return a.size - from + guardOverhead[T](a.size) - guardOverhead[T](from)
+}
+
+// allocateGuarded places a block of sz bytes between two canaries.  extra
+// bytes past the block are reserved physically but not charged to the
+// capacity; SubArena uses them for the sub‑arena's own guards.
+func (a *MemoryArena[T]) allocateGuarded(sz, extra int) (unsafe.Pointer, error) {
+	loff := alignUp(a.offset, a.alignMask)
+	start := a.phys
+	off := alignUp(start+guardSize, a.alignMask)
+	end := off + sz + extra
+	if loff+sz > a.size || end+guardSize > a.limit {
+		a.stats.failures++
+		return nil, ErrArenaFull
+	}
+	a.fill(start, off, canaryByte)
+	a.fill(off, end, 0)
+	a.fill(end, end+guardSize, canaryByte)
+	a.stats.padding += uint64(loff - a.offset)
+	a.offset = loff + sz
+	a.phys = end + guardSize
+	a.guards = append(a.guards, guard{start: start, off: off, size: sz + extra, loff: loff, lsize: sz})
+	return unsafe.Add(a.base, uintptr(off)), nil
+}
+
+// appendGuarded is AppendSlice for instrumented arenas.  A slice ending at the
+// most recent block grows in place, its trailing canary moving along; any
+// other slice that outgrows its capacity moves to a freshly guarded block.
+func (a *MemoryArena[T]) appendGuarded(slice []T, elems ...T) ([]T, error) {
+	need := len(slice) + len(elems)
+	data := unsafe.Pointer(unsafe.SliceData(slice))
+	if need <= cap(slice) && a.contains(data) {
+		out := slice[:need]
+		copy(out[len(slice):], elems)
+		return out, nil
+	}
+	if n := len(a.guards); n > 0 && cap(slice) > 0 && a.contains(data) {
+		g := &a.guards[n-1]
+		off := int(uintptr(data) - uintptr(a.base))
+		if g.lsize == g.size && off >= g.off && off+cap(slice)*a.elemSize == g.off+g.size {
+			loff := g.loff + off - g.off
+			newCap := growCap(need, min(a.size-loff, a.limit-guardSize-off)/a.elemSize)
+			if newCap < need {
+				a.stats.failures++
+				return slice, ErrArenaFull
+			}
+			end := off + newCap*a.elemSize
+			a.fill(g.off+g.size, end, 0)
+			a.fill(end, end+guardSize, canaryByte)
+			g.size, g.lsize = end-g.off, end-g.off
+			a.offset = g.loff + g.lsize
+			a.phys = end + guardSize
+			out := unsafe.Slice((*T)(data), newCap)
+			copy(out[len(slice):], elems)
+			return out[:need], nil
+		}
+	}
+	newCap := growCap(need, (a.size-alignUp(a.offset, a.alignMask))/a.elemSize)
+	if newCap < need {
+		a.stats.failures++
+		return slice, ErrArenaFull
+	}
+	ptr, err := a.allocate(newCap * a.elemSize)
+	if err != nil {
+		return slice, err
+	}
+	out := unsafe.Slice((*T)(ptr), newCap)
+	copy(out, slice)
+	copy(out[len(slice):], elems)
+	return out[:need], nil
+}
+
+// allocateSub reserves n bytes for a sub‑arena and returns the physical
+// bytes past them the sub‑arena may use for its own guards: up to
+// guardOverhead(n), as much as a can spare without shortchanging the
+// capacity it has left.
+func (a *MemoryArena[T]) allocateSub(n int) (unsafe.Pointer, int, error) {
+	loff := alignUp(a.offset, a.alignMask)
+	off := alignUp(a.phys+guardSize, a.alignMask)
+	spare := a.limit - guardSize - off - n - a.physNeed(loff+n)
+	extra := max(min(spare, guardOverhead[T](n)), 0)
+	p, err := a.allocateGuarded(n, extra)
+	return p, extra, err
+}
+
+// releaseGuarded poisons everything allocated at or after logical offset
+// from and forgets its guards.  A block grown in place across from is cut
+// back to it and gets a new trailing canary.
+func (a *MemoryArena[T]) releaseGuarded(from int) {
+	used := max(a.phys, a.offset) // PoolArena bumps offset past the guards
+	n := len(a.guards)
+	for n > 0 && a.guards[n-1].loff >= from {
+		n--
+	}
+	a.guards = a.guards[:n]
+	a.phys = 0
+	if n > 0 {
+		g := &a.guards[n-1]
+		if cut := g.loff + g.lsize - from; cut > 0 {
+			g.size, g.lsize = g.size-cut, g.lsize-cut
+		}
+		a.phys = g.off + g.size + guardSize
+		a.fill(a.phys, used, poisonByte)
+		a.fill(g.off+g.size, a.phys, canaryByte)
+		return
+	}
+	a.fill(0, used, poisonByte)
+}
+
+func (a *MemoryArena[T]) fill(from, to int, b byte) {
+	if to <= from {
+		return
+	}
+	mem := unsafe.Slice((*byte)(unsafe.Add(a.base, from)), to-from)
+	for i := range mem {
+		mem[i] = b
+	}
+}
+
+func (a *MemoryArena[T]) contains(p unsafe.Pointer) bool {
+	return uintptr(p) >= uintptr(a.base) && uintptr(p) < uintptr(a.base)+uintptr(a.limit)
+}
+
+// Verify checks the guard bytes of every live allocation and returns an
+// *OverrunError for the first one that was overwritten.
+func (a *MemoryArena[T]) Verify() error {
+	for _, g := range a.guards {
+		end := g.off + g.size
+		if at := a.findClobbered(g.start, g.off); at >= 0 {
+			return &OverrunError{Offset: g.off, Size: g.size, At: at}
+		}
+		if at := a.findClobbered(end, end+guardSize); at >= 0 {
+			return &OverrunError{Offset: g.off, Size: g.size, At: at}
+		}
+	}
+	return nil
+}
+
+func (a *MemoryArena[T]) findClobbered(from, to int) int {
+	mem := unsafe.Slice((*byte)(unsafe.Add(a.base, from)), to-from)
+	for i, b := range mem {
+		if b != canaryByte {
+			return from + i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,6 @@
+//go:build memoryarena_debug
+
+package memoryArena
+
+// debugArena enables guard bytes, poisoning and Verify bookkeeping.
+const debugArena = true
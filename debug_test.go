@@ -0,0 +1,160 @@
+//go:build memoryarena_debug
+
+package memoryArena
+
+import (
+	"errors"
+	"testing"
+	"unsafe"
+)
+
+func TestDebug_VerifyCatchesOverrun(t *testing.T) {
+	a, _ := newMemoryArena[byte](256)
+	p1, _ := a.Allocate(16)
+	p2, _ := a.Allocate(16)
+	if err := a.Verify(); err != nil {
+		t.Fatalf("clean arena: %v", err)
+	}
+
+	// Write one byte past the end of the second block.
+	unsafe.Slice((*byte)(p2), 17)[16] = 1
+
+	var oe *OverrunError
+	if err := a.Verify(); !errors.As(err, &oe) {
+		t.Fatalf("want *OverrunError, got %v", err)
+	}
+	off2 := int(uintptr(p2) - uintptr(a.Base()))
+	if oe.Offset != off2 || oe.Size != 16 || oe.At != off2+16 {
+		t.Fatalf("unexpected report %+v (block at %d)", oe, off2)
+	}
+	if off1 := int(uintptr(p1) - uintptr(a.Base())); oe.Offset == off1 {
+		t.Fatalf("overrun attributed to the wrong block")
+	}
+}
+
+func TestDebug_VerifyCatchesUnderrun(t *testing.T) {
+	a, _ := newMemoryArena[uint64](256)
+	p, _ := a.Allocate(8)
+	*(*byte)(unsafe.Add(p, -1)) = 0
+	if err := a.Verify(); err == nil {
+		t.Fatalf("underrun not detected")
+	}
+}
+
+func TestDebug_ResetPoisons(t *testing.T) {
+	a, _ := newMemoryArena[byte](256)
+	p, _ := a.Allocate(32)
+	stale := unsafe.Slice((*byte)(p), 32)
+	for i := range stale {
+		stale[i] = 0x11
+	}
+	a.Reset()
+	for i, b := range stale {
+		if b != poisonByte {
+			t.Fatalf("byte %d = %#x after Reset, want poison", i, b)
+		}
+	}
+	if err := a.Verify(); err != nil {
+		t.Fatalf("Verify after Reset: %v", err)
+	}
+	q, _ := a.Allocate(32)
+	for i, b := range unsafe.Slice((*byte)(q), 32) {
+		if b != 0 {
+			t.Fatalf("fresh block byte %d = %#x, want 0", i, b)
+		}
+	}
+}
+
+func TestDebug_RewindPoisonsAndForgetsGuards(t *testing.T) {
+	a, _ := newMemoryArena[byte](256)
+	a.Allocate(8)
+	m := a.Mark()
+	p, _ := a.Allocate(8)
+	if err := a.Rewind(m); err != nil {
+		t.Fatal(err)
+	}
+	if len(a.guards) != 1 {
+		t.Fatalf("guards after Rewind: %d, want 1", len(a.guards))
+	}
+	if b := *(*byte)(p); b != poisonByte {
+		t.Fatalf("rewound byte %#x, want poison", b)
+	}
+}
+
+func TestDebug_AppendSliceMovesToGuardedBlock(t *testing.T) {
+	a, _ := newMemoryArena[int](4096)
+	var s []int
+	for i := 0; i < 40; i++ {
+		s, _ = a.AppendSlice(s, i)
+	}
+	if err := a.Verify(); err != nil {
+		t.Fatalf("Verify: %v", err)
+	}
+	unsafe.Slice(unsafe.SliceData(s), cap(s)+1)[cap(s)] = -1
+	if err := a.Verify(); err == nil {
+		t.Fatalf("overrun past AppendSlice capacity not detected")
+	}
+}
+
+func TestDebug_ConcurrentArenaVerify(t *testing.T) {
+	ca, _ := NewConcurrentArena[byte](128)
+	p, _ := ca.Allocate(4)
+	unsafe.Slice((*byte)(p), 5)[4] = 0
+	if err := ca.(*ConcurrentArena[byte]).Verify(); err == nil {
+		t.Fatalf("overrun not detected")
+	}
+}
+
+// Guards live outside the usable capacity: a debug build fits exactly what a
+// normal build does and reports the same offsets.
+func TestDebug_GuardsOutsideCapacity(t *testing.T) {
+	a, _ := newMemoryArena[uint64](64)
+	for i := 0; i < 8; i++ {
+		if _, err := a.NewObject(uint64(i)); err != nil {
+			t.Fatalf("object %d: %v", i, err)
+		}
+	}
+	if a.Offset() != 64 {
+		t.Fatalf("Offset %d, want 64", a.Offset())
+	}
+	if _, err := a.NewObject(8); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+
+	sub, err := a.SubArena(1)
+	if err != ErrArenaFull || sub != nil {
+		t.Fatalf("SubArena on a full arena: %v", err)
+	}
+	a.Reset()
+	s, err := a.SubArena(64)
+	if err != nil {
+		t.Fatalf("SubArena(64): %v", err)
+	}
+	for i := 0; i < 8; i++ {
+		if _, err := s.NewObject(uint64(i)); err != nil {
+			t.Fatalf("sub object %d: %v", i, err)
+		}
+	}
+	if err := a.Verify(); err != nil {
+		t.Fatalf("parent Verify: %v", err)
+	}
+	if err := s.(*MemoryArena[uint64]).Verify(); err != nil {
+		t.Fatalf("sub Verify: %v", err)
+	}
+}
+
+func TestDebug_RewindCutsBlockGrownInPlace(t *testing.T) {
+	a, _ := newMemoryArena[int](1024)
+	s, _ := a.AppendSlice(nil, 1, 2)
+	m := a.Mark()
+	s, _ = a.AppendSlice(s[:cap(s)], 3) // grows past the mark
+	if err := a.Rewind(m); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.Verify(); err != nil {
+		t.Fatalf("Verify after Rewind: %v", err)
+	}
+	if b := *(*byte)(unsafe.Add(unsafe.Pointer(&s[0]), m.offset)); b != canaryByte {
+		t.Fatalf("byte past the cut %#x, want a canary", b)
+	}
+}
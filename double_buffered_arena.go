@@ -0,0 +1,79 @@
+package memoryArena
+
+import "unsafe"
+
+// DoubleBufferedArena flips between two arenas, one per frame or batch.
+// Swap resets the older arena and makes it current, so objects allocated for
+// batch N stay valid while batch N+1 is being built and are only released
+// when batch N+2 begins.
+//
+// Swap and Reset must not run concurrently with allocations; with atomic
+// arenas underneath, allocations within a batch may be concurrent.
+type DoubleBufferedArena[T any] struct {
+	arenas [2]Arena[T]
+	cur    int
+}
+
+// NewDoubleBufferedArena creates both arenas with newArena(size).  A nil
+// newArena uses NewMemoryArena[T]; pass NewAtomicArena[T] for batches that
+// are filled by several goroutines.
+func NewDoubleBufferedArena[T any](size int, newArena func(int) (Arena[T], error)) (*DoubleBufferedArena[T], error) {
+	if newArena == nil {
+		newArena = NewMemoryArena[T]
+	}
+	d := &DoubleBufferedArena[T]{}
+	for i := range d.arenas {
+		a, err := newArena(size)
+		if err != nil {
+			return nil, err
+		}
+		d.arenas[i] = a
+	}
+	return d, nil
+}
+
+// Swap starts a new batch: the arena holding the batch before the current
+// one is reset and becomes current.
+func (d *DoubleBufferedArena[T]) Swap() {
+	next := d.cur ^ 1
+	d.arenas[next].Reset()
+	d.cur = next
+}
+
+// Current returns the arena new allocations go to.
+func (d *DoubleBufferedArena[T]) Current() Arena[T] {
+	return d.arenas[d.cur]
+}
+
+// Previous returns the arena holding the previous batch.
+func (d *DoubleBufferedArena[T]) Previous() Arena[T] {
+	return d.arenas[d.cur^1]
+}
+
+func (d *DoubleBufferedArena[T]) Allocate(sz int) (unsafe.Pointer, error) {
+	return d.arenas[d.cur].Allocate(sz)
+}
+
+func (d *DoubleBufferedArena[T]) NewObject(obj T) (*T, error) {
+	return d.arenas[d.cur].NewObject(obj)
+}
+
+func (d *DoubleBufferedArena[T]) AppendSlice(slice []T, elems ...T) ([]T, error) {
+	return d.arenas[d.cur].AppendSlice(slice, elems...)
+}
+
+// Reset releases both batches.
+func (d *DoubleBufferedArena[T]) Reset() {
+	d.arenas[0].Reset()
+	d.arenas[1].Reset()
+}
+
+// Offset returns the allocation offset of the current arena.
+func (d *DoubleBufferedArena[T]) Offset() int {
+	return d.arenas[d.cur].Offset()
+}
+
+// Base returns the start of the current arena.
+func (d *DoubleBufferedArena[T]) Base() unsafe.Pointer {
+	return d.arenas[d.cur].Base()
+}
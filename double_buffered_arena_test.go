@@ -0,0 +1,82 @@
+package memoryArena
+
+import (
+	"sync"
+	"testing"
+)
+
+var _ Arena[int] = (*DoubleBufferedArena[int])(nil)
+
+func TestDoubleBufferedArena_DataSurvivesOneSwap(t *testing.T) {
+	d, err := NewDoubleBufferedArena[int](1024, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	batchN, _ := d.NewObject(1)
+
+	d.Swap() // batch N+1
+	batchN1, _ := d.NewObject(2)
+	if *batchN != 1 {
+		t.Fatalf("batch N released too early: %d", *batchN)
+	}
+	if d.Previous().Offset() == 0 {
+		t.Fatalf("previous arena should still hold batch N")
+	}
+
+	d.Swap() // batch N+2
+	released := 0
+	if debugArena { // released memory is poisoned rather than zeroed
+		poison := ^uint64(0) / 0xFF * poisonByte
+		released = int(poison)
+	}
+	if *batchN != released {
+		t.Fatalf("batch N not released at N+2: %#x", *batchN)
+	}
+	if *batchN1 != 2 {
+		t.Fatalf("batch N+1 released too early: %d", *batchN1)
+	}
+	if d.Offset() != 0 {
+		t.Fatalf("current arena not empty after Swap: %d", d.Offset())
+	}
+}
+
+func TestDoubleBufferedArena_Reset(t *testing.T) {
+	d, _ := NewDoubleBufferedArena[int](1024, nil)
+	d.NewObject(1)
+	d.Swap()
+	d.NewObject(2)
+	d.Reset()
+	if d.Current().Offset() != 0 || d.Previous().Offset() != 0 {
+		t.Fatalf("Reset left allocations behind")
+	}
+}
+
+func TestDoubleBufferedArena_Errors(t *testing.T) {
+	if _, err := NewDoubleBufferedArena[int](0, nil); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+}
+
+func TestDoubleBufferedArena_AtomicBatches(t *testing.T) {
+	d, _ := NewDoubleBufferedArena[int](1<<16, NewAtomicArena[int])
+	for batch := 0; batch < 10; batch++ {
+		var wg sync.WaitGroup
+		for w := 0; w < 4; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := 0; i < 100; i++ {
+					if _, err := d.NewObject(batch); err != nil {
+						t.Errorf("NewObject: %v", err)
+						return
+					}
+				}
+			}()
+		}
+		wg.Wait()
+		if d.Offset() != 400*8 {
+			t.Fatalf("batch %d: offset %d", batch, d.Offset())
+		}
+		d.Swap()
+	}
+}
@@ -0,0 +1,117 @@
+package memoryArena
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// EpochArena is an AtomicArena variant that can be Reset while other
+// goroutines keep allocating.  Allocating goroutines bracket their work with
+// Enter and Exit; Reset starts a new epoch, waits until every goroutine that
+// entered the previous one has left, and only then clears its memory.
+//
+// To let allocation continue during that wait, the arena alternates between
+// two regions of `size` bytes: even epochs allocate from one, odd epochs from
+// the other.  Objects allocated in an epoch stay valid until the Reset that
+// ends it returns, and a goroutine may keep using them as long as it stays
+// inside Enter/Exit.  Allocations made outside Enter/Exit are not protected.
+type EpochArena[T any] struct {
+	epoch   uint64 // current epoch (atomic)
+	active  [2]epochCounter
+	regions [2]*AtomicArena[T]
+	resetMu sync.Mutex // serialises Reset
+}
+
+// epochCounter counts the goroutines inside one epoch parity, padded so the
+// two counters do not share a cache line.
+type epochCounter struct {
+	n int64 // (atomic)
+	_ [56]byte
+}
+
+// Epoch identifies the epoch a goroutine entered; pass it back to Exit.
+type Epoch struct {
+	e uint64
+}
+
+// NewEpochArena allocates two regions of at least `size` bytes each.
+func NewEpochArena[T any](size int) (*EpochArena[T], error) {
+	a := &EpochArena[T]{}
+	for i := range a.regions {
+		r, err := NewAtomicArena[T](size)
+		if err != nil {
+			return nil, err
+		}
+		a.regions[i] = r.(*AtomicArena[T])
+	}
+	return a, nil
+}
+
+// Enter registers the caller as a participant of the current epoch.  Memory
+// it allocates, and memory allocated in the current epoch, stays valid until
+// the matching Exit.
+func (a *EpochArena[T]) Enter() Epoch {
+	for {
+		e := atomic.LoadUint64(&a.epoch)
+		atomic.AddInt64(&a.active[e&1].n, 1)
+		if atomic.LoadUint64(&a.epoch) == e {
+			return Epoch{e: e}
+		}
+		// A Reset moved on between the load and the registration.
+		atomic.AddInt64(&a.active[e&1].n, -1)
+	}
+}
+
+// Exit ends the participation started by Enter.
+func (a *EpochArena[T]) Exit(ep Epoch) {
+	if atomic.AddInt64(&a.active[ep.e&1].n, -1) < 0 {
+		panic("memory arena: Exit without matching Enter")
+	}
+}
+
+// current returns the region of the current epoch.
+func (a *EpochArena[T]) current() *AtomicArena[T] {
+	return a.regions[atomic.LoadUint64(&a.epoch)&1]
+}
+
+// Allocate reserves sz bytes, aligned for T, in the current epoch's region.
+func (a *EpochArena[T]) Allocate(sz int) (unsafe.Pointer, error) {
+	return a.current().Allocate(sz)
+}
+
+// NewObject allocates space for T, copies obj into it, and returns *T.
+func (a *EpochArena[T]) NewObject(obj T) (*T, error) {
+	return a.current().NewObject(obj)
+}
+
+// AppendSlice appends elems to slice, growing it in the current epoch's region.
+func (a *EpochArena[T]) AppendSlice(slice []T, elems ...T) ([]T, error) {
+	return a.current().AppendSlice(slice, elems...)
+}
+
+// Reset ends the current epoch.  New allocations immediately move to the other
+// region; Reset then blocks until every participant of the ended epoch has
+// called Exit and zeroes its region.  It is safe to call concurrently with
+// allocations and with other Resets.
+func (a *EpochArena[T]) Reset() {
+	a.resetMu.Lock()
+	defer a.resetMu.Unlock()
+
+	old := atomic.AddUint64(&a.epoch, 1) - 1
+	for atomic.LoadInt64(&a.active[old&1].n) != 0 {
+		runtime.Gosched()
+	}
+	a.regions[old&1].Reset()
+}
+
+// Offset returns the allocation offset within the current epoch's region.
+func (a *EpochArena[T]) Offset() int {
+	return a.current().Offset()
+}
+
+// Base returns the start of the current epoch's region.
+func (a *EpochArena[T]) Base() unsafe.Pointer {
+	return a.current().Base()
+}
@@ -0,0 +1,110 @@
+package memoryArena
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+var _ Arena[int] = (*EpochArena[int])(nil)
+
+func TestEpochArena_Basic(t *testing.T) {
+	a, err := NewEpochArena[int](1024)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ep := a.Enter()
+	p, _ := a.NewObject(1)
+	a.Exit(ep)
+
+	a.Reset()
+	if a.Offset() != 0 {
+		t.Fatalf("new epoch region not empty: %d", a.Offset())
+	}
+	q, _ := a.NewObject(2)
+	a.Reset()
+	if *p != 0 {
+		t.Fatalf("region of the ended epoch was not cleared: %d", *p)
+	}
+	if *q != 0 {
+		t.Fatalf("second Reset did not clear the second region: %d", *q)
+	}
+}
+
+func TestEpochArena_ExitWithoutEnterPanics(t *testing.T) {
+	a, _ := NewEpochArena[int](64)
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("expected panic")
+		}
+	}()
+	a.Exit(Epoch{})
+}
+
+// Reset waits for participants of the ended epoch before clearing it.
+func TestEpochArena_ResetWaitsForParticipants(t *testing.T) {
+	a, _ := NewEpochArena[int](1024)
+	ep := a.Enter()
+	p, _ := a.NewObject(42)
+
+	var reset atomic.Bool
+	done := make(chan struct{})
+	go func() {
+		a.Reset()
+		reset.Store(true)
+		close(done)
+	}()
+
+	// New allocations proceed in the other region while Reset is blocked.
+	for atomic.LoadUint64(&a.epoch) == 0 {
+		runtime.Gosched()
+	}
+	if _, err := a.NewObject(7); err != nil {
+		t.Fatalf("NewObject during Reset: %v", err)
+	}
+	if reset.Load() || *p != 42 {
+		t.Fatalf("Reset cleared memory of an active participant")
+	}
+	a.Exit(ep)
+	<-done
+	if *p != 0 {
+		t.Fatalf("region not cleared after participants left")
+	}
+}
+
+func TestEpochArena_ConcurrentReset(t *testing.T) {
+	a, _ := NewEpochArena[uint64](1 << 16)
+	var (
+		wg   sync.WaitGroup
+		stop atomic.Bool
+	)
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w uint64) {
+			defer wg.Done()
+			for i := uint64(0); !stop.Load(); i++ {
+				ep := a.Enter()
+				var objs [16]*uint64
+				for j := range objs {
+					p, err := a.NewObject(w<<32 | i)
+					if err != nil {
+						break
+					}
+					objs[j] = p
+				}
+				for _, p := range objs {
+					if p != nil && *p != w<<32|i {
+						t.Errorf("object changed while inside the epoch: %x", *p)
+					}
+				}
+				a.Exit(ep)
+			}
+		}(uint64(w))
+	}
+	for i := 0; i < 200; i++ {
+		a.Reset()
+	}
+	stop.Store(true)
+	wg.Wait()
+}
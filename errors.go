@@ -8,4 +8,9 @@ var (
 	ErrInvalidSize     = errors.New("memory arena: size must be greater than 0")
 	ErrNewSizeTooSmall = errors.New("memory arena: new size is smaller than current usage")
 	ErrInvalidType     = errors.New("memory arena: invalid object type for this arena")
+	ErrInvalidMark     = errors.New("memory arena: mark is stale or belongs to another arena")
+	ErrArenaReleased   = errors.New("memory arena: arena has been released")
+	ErrStaleHandle     = errors.New("memory arena: handle used after its arena was reset")
+	ErrInvalidPosition = errors.New("memory arena: position outside the unreleased range")
+	ErrInvalidPointer  = errors.New("memory arena: pointer was not allocated by this arena")
 )
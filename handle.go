@@ -0,0 +1,57 @@
+package memoryArena
+
+// Generational is implemented by arenas that count their Resets.  MemoryArena,
+// ConcurrentArena and AtomicArena all do.
+type Generational interface {
+	Generation() uint64
+}
+
+// Handle is a checked reference to an arena object.  It remembers the arena's
+// generation at allocation time, so using it after the arena was Reset is
+// reported instead of silently aliasing whatever was allocated since.
+//
+// Handles are an opt‑in debugging aid: they cost one generation load per Get
+// and do not notice Rewind, which leaves the generation unchanged.
+type Handle[T any] struct {
+	ptr   *T
+	gen   uint64
+	arena Generational
+}
+
+// NewHandle allocates obj in a like NewObject and returns a Handle to it.
+// Arenas that do not implement Generational are rejected with ErrInvalidType.
+func NewHandle[T any](a Arena[T], obj T) (Handle[T], error) {
+	g, ok := a.(Generational)
+	if !ok {
+		return Handle[T]{}, ErrInvalidType
+	}
+	gen := g.Generation()
+	p, err := a.NewObject(obj)
+	if err != nil {
+		return Handle[T]{}, err
+	}
+	return Handle[T]{ptr: p, gen: gen, arena: g}, nil
+}
+
+// Valid reports whether the arena has not been reset since allocation.
+func (h Handle[T]) Valid() bool {
+	return h.arena != nil && h.arena.Generation() == h.gen
+}
+
+// Get returns the object, or ErrStaleHandle if the arena was reset since it
+// was allocated (or h is the zero Handle).
+func (h Handle[T]) Get() (*T, error) {
+	if !h.Valid() {
+		return nil, ErrStaleHandle
+	}
+	return h.ptr, nil
+}
+
+// MustGet is like Get but panics on a stale handle.
+func (h Handle[T]) MustGet() *T {
+	p, err := h.Get()
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
@@ -0,0 +1,83 @@
+package memoryArena
+
+import "testing"
+
+func TestHandle_DetectsReset(t *testing.T) {
+	arenas := map[string]func(int) (Arena[point], error){
+		"MemoryArena":     NewMemoryArena[point],
+		"AtomicArena":     NewAtomicArena[point],
+		"ConcurrentArena": NewConcurrentArena[point],
+	}
+	for name, newArena := range arenas {
+		t.Run(name, func(t *testing.T) {
+			a, _ := newArena(1024)
+			h, err := NewHandle(a, point{1, 2})
+			if err != nil {
+				t.Fatalf("NewHandle: %v", err)
+			}
+			p, err := h.Get()
+			if err != nil || p.X != 1 || p.Y != 2 {
+				t.Fatalf("Get before Reset: %v %+v", err, p)
+			}
+			a.Reset()
+			a.NewObject(point{9, 9})
+			if h.Valid() {
+				t.Fatalf("handle still valid after Reset")
+			}
+			if _, err := h.Get(); err != ErrStaleHandle {
+				t.Fatalf("want ErrStaleHandle, got %v", err)
+			}
+			h2, _ := NewHandle(a, point{3, 4})
+			if p := h2.MustGet(); p.X != 3 {
+				t.Fatalf("fresh handle: %+v", *p)
+			}
+		})
+	}
+}
+
+// Resetting the parent invalidates a sub‑arena's handles as well.
+func TestHandle_DetectsParentReset(t *testing.T) {
+	parent, _ := NewMemoryArena[int](1024)
+	sub, err := parent.(*MemoryArena[int]).SubArena(64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h, _ := NewHandle(sub, 5)
+	m := sub.(Rewinder).Mark()
+	parent.Reset()
+	parent.NewObject(99)
+	if p, err := h.Get(); err != ErrStaleHandle {
+		t.Fatalf("want ErrStaleHandle, got %v (value %d)", err, *p)
+	}
+	if err := sub.(Rewinder).Rewind(m); err != ErrInvalidMark {
+		t.Fatalf("want ErrInvalidMark, got %v", err)
+	}
+}
+
+func TestHandle_MustGetPanics(t *testing.T) {
+	a, _ := NewMemoryArena[int](64)
+	h, _ := NewHandle(a, 1)
+	a.Reset()
+	defer func() {
+		if r := recover(); r != ErrStaleHandle {
+			t.Fatalf("want panic ErrStaleHandle, got %v", r)
+		}
+	}()
+	h.MustGet()
+}
+
+func TestHandle_ZeroAndUnsupported(t *testing.T) {
+	var h Handle[int]
+	if _, err := h.Get(); err != ErrStaleHandle {
+		t.Fatalf("zero handle: want ErrStaleHandle, got %v", err)
+	}
+	c, _ := NewChunkedArena[int](64, GrowthPolicy{})
+	if _, err := NewHandle[int](c, 1); err != ErrInvalidType {
+		t.Fatalf("want ErrInvalidType, got %v", err)
+	}
+	a, _ := NewMemoryArena[int](8)
+	a.NewObject(0)
+	if _, err := NewHandle(a, 1); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+}
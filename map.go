@@ -0,0 +1,238 @@
+package memoryArena
+
+import (
+	"encoding/binary"
+	"hash/maphash"
+	"math"
+	"reflect"
+	"unsafe"
+)
+
+// MapEntry is one slot of a Map's table.  It is exported only so callers can
+// name the arena type, e.g. NewMemoryArena[MapEntry[string, int]].
+type MapEntry[K comparable, V any] struct {
+	key  K
+	val  V
+	hash uint64
+	used bool
+}
+
+// Map is a hash map whose table lives in an arena, for short‑lived maps on
+// hot paths that would otherwise be heap allocated per request.  It uses open
+// addressing with linear probing and backward‑shift deletion, and doubles its
+// table – rehashing into new arena memory – once it is three quarters full.
+// Outgrown tables are cleared but their space is only reclaimed by the
+// arena's Reset, which also discards the map wholesale: a Map must not be
+// used after its arena is Reset or Rewound past it.
+//
+// Map is no more goroutine‑safe than its arena.
+type Map[K comparable, V any] struct {
+	arena   Arena[MapEntry[K, V]]
+	entries []MapEntry[K, V]
+	mask    uint64
+	count   int
+	hash    func(K) uint64
+}
+
+// minMapSlots is the smallest table a Map allocates.
+const minMapSlots = 8
+
+// NewMap returns an empty map in a with room for capacity entries before it
+// has to grow.  hash may be nil for keys whose underlying type is a string,
+// integer, float, boolean, pointer or channel, and for arrays and structs
+// built only from integers and booleans without padding; other key types need
+// a hash function and are otherwise rejected with ErrInvalidType.  Equal keys
+// must hash equally.
+func NewMap[K comparable, V any](a Arena[MapEntry[K, V]], capacity int, hash func(K) uint64) (*Map[K, V], error) {
+	if capacity < 0 {
+		return nil, ErrInvalidSize
+	}
+	if hash == nil {
+		if hash = defaultHasher[K](maphash.MakeSeed()); hash == nil {
+			return nil, ErrInvalidType
+		}
+	}
+	m := &Map[K, V]{arena: a, hash: hash}
+	if err := m.rehash(mapSlots(capacity)); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// mapSlots returns the table size that holds n entries under the load limit.
+func mapSlots(n int) int {
+	return max(nextPow2(n+n/3+1), minMapSlots)
+}
+
+// rehash moves every entry into a fresh table of n slots.  On failure the map
+// is left as it was.
+func (m *Map[K, V]) rehash(n int) error {
+	var dummy MapEntry[K, V]
+	ptr, err := m.arena.Allocate(n * int(unsafe.Sizeof(dummy)))
+	if err != nil {
+		return err
+	}
+	old := m.entries
+	m.entries = unsafe.Slice((*MapEntry[K, V])(ptr), n)
+	m.mask = uint64(n - 1)
+	for i := range old {
+		if e := &old[i]; e.used {
+			j := e.hash & m.mask
+			for m.entries[j].used {
+				j = (j + 1) & m.mask
+			}
+			m.entries[j] = *e
+		}
+	}
+	clear(old) // drop the references the abandoned table holds
+	return nil
+}
+
+// find returns the slot holding k, or the empty slot ending its probe
+// sequence with found == false.
+func (m *Map[K, V]) find(k K, h uint64) (i uint64, found bool) {
+	for i = h & m.mask; m.entries[i].used; i = (i + 1) & m.mask {
+		if e := &m.entries[i]; e.hash == h && e.key == k {
+			return i, true
+		}
+	}
+	return i, false
+}
+
+// Get returns the value stored for k and whether it was present.
+func (m *Map[K, V]) Get(k K) (V, bool) {
+	i, ok := m.find(k, m.hash(k))
+	if !ok {
+		var zero V
+		return zero, false
+	}
+	return m.entries[i].val, true
+}
+
+// Put stores v under k.  Growing the table can fail with the arena's error, in
+// which case the map is unchanged.
+func (m *Map[K, V]) Put(k K, v V) error {
+	h := m.hash(k)
+	i, ok := m.find(k, h)
+	if ok {
+		m.entries[i].val = v
+		return nil
+	}
+	if (m.count+1)*4 > len(m.entries)*3 {
+		if err := m.rehash(2 * len(m.entries)); err != nil {
+			return err
+		}
+		i, _ = m.find(k, h)
+	}
+	m.entries[i] = MapEntry[K, V]{key: k, val: v, hash: h, used: true}
+	m.count++
+	return nil
+}
+
+// Delete removes k and reports whether it was present.  Later entries of the
+// probe sequence are shifted back, so no tombstones accumulate.
+func (m *Map[K, V]) Delete(k K) bool {
+	i, ok := m.find(k, m.hash(k))
+	if !ok {
+		return false
+	}
+	for j := (i + 1) & m.mask; m.entries[j].used; j = (j + 1) & m.mask {
+		// Move entry j into the hole unless its home slot lies cyclically
+		// in (i, j], where the hole does not interrupt its probe sequence.
+		home := m.entries[j].hash & m.mask
+		if (j-home)&m.mask >= (j-i)&m.mask {
+			m.entries[i] = m.entries[j]
+			i = j
+		}
+	}
+	m.entries[i] = MapEntry[K, V]{}
+	m.count--
+	return true
+}
+
+// Range calls f for every entry, in table order, until f returns false.  The
+// map must not be modified during the iteration.
+func (m *Map[K, V]) Range(f func(k K, v V) bool) {
+	for i := range m.entries {
+		if e := &m.entries[i]; e.used && !f(e.key, e.val) {
+			return
+		}
+	}
+}
+
+// Len returns the number of entries.
+func (m *Map[K, V]) Len() int {
+	return m.count
+}
+
+// defaultHasher returns a seeded hash function for K, or nil when K needs a
+// caller‑supplied one.
+func defaultHasher[K comparable](seed maphash.Seed) func(K) uint64 {
+	t := reflect.TypeOf((*K)(nil)).Elem()
+	switch t.Kind() {
+	case reflect.String:
+		return func(k K) uint64 {
+			return maphash.String(seed, *(*string)(unsafe.Pointer(&k)))
+		}
+	case reflect.Float32:
+		return func(k K) uint64 {
+			f := *(*float32)(unsafe.Pointer(&k))
+			if f == 0 {
+				f = 0 // +0 and -0 are equal keys
+			}
+			return hashWord(seed, uint64(math.Float32bits(f)))
+		}
+	case reflect.Float64:
+		return func(k K) uint64 {
+			f := *(*float64)(unsafe.Pointer(&k))
+			if f == 0 {
+				f = 0
+			}
+			return hashWord(seed, math.Float64bits(f))
+		}
+	case reflect.Pointer, reflect.UnsafePointer, reflect.Chan:
+		// Go's heap objects do not move, so the address is a stable hash.
+		return func(k K) uint64 {
+			return hashWord(seed, uint64(*(*uintptr)(unsafe.Pointer(&k))))
+		}
+	}
+	if !memHashable(t) {
+		return nil
+	}
+	size := int(t.Size())
+	return func(k K) uint64 {
+		return maphash.Bytes(seed, unsafe.Slice((*byte)(unsafe.Pointer(&k)), size))
+	}
+}
+
+// hashWord hashes a single machine word.
+func hashWord(seed maphash.Seed, w uint64) uint64 {
+	var b [8]byte
+	binary.NativeEndian.PutUint64(b[:], w)
+	return maphash.Bytes(seed, b[:])
+}
+
+// memHashable reports whether equal values of t always have identical bytes:
+// integers and booleans, and arrays and structs made only of them with no
+// padding or blank fields.
+func memHashable(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	case reflect.Array:
+		return memHashable(t.Elem())
+	case reflect.Struct:
+		var size uintptr
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			// == ignores blank fields, so their bytes must not be hashed.
+			if f.Name == "_" || f.Offset != size || !memHashable(f.Type) {
+				return false
+			}
+			size += f.Type.Size()
+		}
+		return size == t.Size()
+	}
+	return false
+}
@@ -0,0 +1,219 @@
+package memoryArena
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+)
+
+func TestMap_PutGetDelete(t *testing.T) {
+	a, _ := NewMemoryArena[MapEntry[string, int]](1 << 20)
+	m, err := NewMap[string, int](a, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	const n = 1000
+	for i := 0; i < n; i++ {
+		if err := m.Put(fmt.Sprint("k", i), i); err != nil {
+			t.Fatalf("Put %d: %v", i, err)
+		}
+	}
+	m.Put("k7", -7)
+	if m.Len() != n {
+		t.Fatalf("Len %d, want %d", m.Len(), n)
+	}
+	for i := 0; i < n; i++ {
+		want := i
+		if i == 7 {
+			want = -7
+		}
+		if v, ok := m.Get(fmt.Sprint("k", i)); !ok || v != want {
+			t.Fatalf("Get k%d = %d %v", i, v, ok)
+		}
+	}
+	if _, ok := m.Get("missing"); ok {
+		t.Fatalf("Get of a missing key reported ok")
+	}
+	for i := 0; i < n; i += 2 {
+		if !m.Delete(fmt.Sprint("k", i)) {
+			t.Fatalf("Delete k%d reported missing", i)
+		}
+	}
+	if m.Delete("k0") || m.Len() != n/2 {
+		t.Fatalf("second Delete succeeded or Len %d wrong", m.Len())
+	}
+	for i := 1; i < n; i += 2 {
+		if _, ok := m.Get(fmt.Sprint("k", i)); !ok {
+			t.Fatalf("k%d lost after deleting its neighbours", i)
+		}
+	}
+}
+
+// TestMap_MatchesBuiltin runs random operations against a small table so
+// probe sequences wrap and collide, checking the result against a map.
+func TestMap_MatchesBuiltin(t *testing.T) {
+	a, _ := NewMemoryArena[MapEntry[uint16, int]](1 << 20)
+	m, _ := NewMap[uint16, int](a, 0, func(k uint16) uint64 { return uint64(k % 5) })
+	ref := map[uint16]int{}
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		k := uint16(r.Intn(64))
+		switch r.Intn(3) {
+		case 0, 1:
+			m.Put(k, i)
+			ref[k] = i
+		case 2:
+			_, want := ref[k]
+			delete(ref, k)
+			if got := m.Delete(k); got != want {
+				t.Fatalf("op %d: Delete(%d) = %v, want %v", i, k, got, want)
+			}
+		}
+		if m.Len() != len(ref) {
+			t.Fatalf("op %d: Len %d, want %d", i, m.Len(), len(ref))
+		}
+	}
+	for k, want := range ref {
+		if v, ok := m.Get(k); !ok || v != want {
+			t.Fatalf("Get(%d) = %d %v, want %d", k, v, ok, want)
+		}
+	}
+	seen := 0
+	m.Range(func(k uint16, v int) bool {
+		if ref[k] != v {
+			t.Fatalf("Range yielded %d=%d, want %d", k, v, ref[k])
+		}
+		seen++
+		return true
+	})
+	if seen != len(ref) {
+		t.Fatalf("Range visited %d entries, want %d", seen, len(ref))
+	}
+}
+
+func TestMap_DefaultHashers(t *testing.T) {
+	type pair struct{ A, B int32 }
+	af, _ := NewMemoryArena[MapEntry[float64, int]](4096)
+	mf, _ := NewMap[float64, int](af, 0, nil)
+	mf.Put(math.Copysign(0, -1), 1)
+	if v, ok := mf.Get(0); !ok || v != 1 {
+		t.Fatalf("-0 and +0 should be the same key")
+	}
+
+	ap, _ := NewMemoryArena[MapEntry[pair, string]](4096)
+	mp, err := NewMap[pair, string](ap, 0, nil)
+	if err != nil {
+		t.Fatalf("padding‑free struct key rejected: %v", err)
+	}
+	mp.Put(pair{1, 2}, "x")
+	if v, _ := mp.Get(pair{1, 2}); v != "x" {
+		t.Fatalf("struct key lookup failed")
+	}
+
+	// Named types hash by their underlying kind.
+	type id string
+	as, _ := NewMemoryArena[MapEntry[id, int]](4096)
+	ms, err := NewMap[id, int](as, 0, nil)
+	if err != nil {
+		t.Fatalf("named string key rejected: %v", err)
+	}
+	ms.Put(id("a"), 1)
+	if v, ok := ms.Get(id("a")); !ok || v != 1 {
+		t.Fatalf("named string key lookup failed")
+	}
+	type celsius float64
+	ac, _ := NewMemoryArena[MapEntry[celsius, int]](4096)
+	mc, err := NewMap[celsius, int](ac, 0, nil)
+	if err != nil {
+		t.Fatalf("named float key rejected: %v", err)
+	}
+	mc.Put(celsius(math.Copysign(0, -1)), 2)
+	if v, ok := mc.Get(0); !ok || v != 2 {
+		t.Fatalf("named float key: -0 and +0 should be the same key")
+	}
+
+	type padded struct {
+		A int8
+		B int64
+	}
+	aq, _ := NewMemoryArena[MapEntry[padded, int]](4096)
+	if _, err := NewMap[padded, int](aq, 0, nil); err != ErrInvalidType {
+		t.Fatalf("padded key: want ErrInvalidType, got %v", err)
+	}
+	ai, _ := NewMemoryArena[MapEntry[any, int]](4096)
+	if _, err := NewMap[any, int](ai, 0, nil); err != ErrInvalidType {
+		t.Fatalf("interface key: want ErrInvalidType, got %v", err)
+	}
+}
+
+func TestMap_GrowthFailureKeepsMap(t *testing.T) {
+	a, _ := NewMemoryArena[MapEntry[int, int]](8 * 32)
+	m, err := NewMap[int, int](a, 0, nil)
+	if err != nil {
+		t.Fatalf("NewMap: %v", err)
+	}
+	i := 0
+	for ; err == nil; i++ {
+		err = m.Put(i, i)
+	}
+	if err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+	if m.Len() != i-1 {
+		t.Fatalf("Len %d after failed Put, want %d", m.Len(), i-1)
+	}
+	for j := 0; j < i-1; j++ {
+		if v, ok := m.Get(j); !ok || v != j {
+			t.Fatalf("entry %d lost after failed growth", j)
+		}
+	}
+}
+
+func TestMap_KeepsPointersAlive(t *testing.T) {
+	a, _ := NewMemoryArena[MapEntry[int, *person]](1 << 16)
+	m, _ := NewMap[int, *person](a, 0, nil)
+	for i := 0; i < 200; i++ {
+		p := newPerson(i)
+		m.Put(i, &p)
+	}
+	churnHeap()
+	for i := 0; i < 200; i++ {
+		p, _ := m.Get(i)
+		checkPerson(t, p, i)
+	}
+}
+
+func BenchmarkMap(b *testing.B) {
+	const n = 512
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = fmt.Sprint("key-", i)
+	}
+	b.Run("Arena", func(b *testing.B) {
+		a, _ := NewMemoryArena[MapEntry[string, int]](1 << 20)
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			m, _ := NewMap[string, int](a, 0, nil)
+			for j, k := range keys {
+				m.Put(k, j)
+			}
+			for _, k := range keys {
+				m.Get(k)
+			}
+			a.Reset()
+		}
+	})
+	b.Run("Builtin", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			m := map[string]int{}
+			for j, k := range keys {
+				m[k] = j
+			}
+			for _, k := range keys {
+				_ = m[k]
+			}
+		}
+	})
+}
@@ -12,7 +12,8 @@ package memoryArena
 // faster than the original – but now passes `go test -race` and `checkptr`.
 //
 // ▸ Allocate / NewObject take ~14 ns each on Go 1.22 amd64.
-// ▸ Reset zeros memory via `runtime.memclrNoHeapPointers`.
+// ▸ Reset zeros memory via `runtime.memclrNoHeapPointers` (a typed clear when
+//   T holds pointers – see backing.go).
 // ▸ nextPow2 uses one `bits.Len` instruction.
 //
 // Caveat: still NOT goroutine‑safe.
@@ -21,7 +22,7 @@ package memoryArena
 
 import (
 	"math/bits"
-	"reflect"
+	"sync/atomic"
 	"unsafe"
 	_ "unsafe" // go:linkname
 )
@@ -34,13 +35,23 @@ func memclrNoHeapPointers(ptr unsafe.Pointer, n uintptr)
 // All fields are private; no direct external mutation allowed.
 
 type MemoryArena[T any] struct {
-	buffer    []byte         // backing storage (kept to satisfy GC & checkptr)
-	base      unsafe.Pointer // first aligned byte inside buffer
-	size      int            // usable capacity in bytes
-	offset    int            // current allocation offset (≤ size)
-	alignMask int            // alignment‑1 of T
-	elemSize  int            // sizeof(T)
-	zeroBuf   []byte         // kept for unit‑test expectations
+	buffer    []byte          // backing storage (kept to satisfy GC & checkptr)
+	objects   []T             // typed backing storage when T holds pointers
+	base      unsafe.Pointer  // first aligned byte inside buffer
+	size      int             // usable capacity in bytes
+	reserved  int             // bytes the backing provides past base (≥ size)
+	offset    int             // current allocation offset (≤ size)
+	phys      int             // debug builds: offset past the last guard (see debug.go)
+	limit     int             // debug builds: bytes past base the guards may use
+	alignMask int             // alignment‑1 of T
+	elemSize  int             // sizeof(T)
+	pointers  bool            // backing is GC‑scanned; allocations are whole T slots
+	retired   []region[T]     // backings outgrown by Resize, alive until Reset
+	gen       uint64          // bumped by Reset; invalidates marks and handles (atomic)
+	parent    *MemoryArena[T] // sub‑arenas: the arena the memory belongs to
+	guards    []guard         // debug builds: live allocations checked by Verify
+	stats     counters        // see Stats
+	zeroBuf   []byte          // kept for unit‑test expectations
 }
 
 func (a *MemoryArena[T]) Offset() int {
@@ -51,53 +62,83 @@ func (a *MemoryArena[T]) Base() unsafe.Pointer {
 	return a.base
 }
 
+// Generation returns the number of times the arena has been reset.  For a
+// sub‑arena it also counts the resets of its parents, which invalidate it.
+func (a *MemoryArena[T]) Generation() uint64 {
+	g := atomic.LoadUint64(&a.gen)
+	if a.parent != nil {
+		g += a.parent.Generation()
+	}
+	return g
+}
+
 // NewMemoryArena allocates an arena with at least `size` bytes of usable space.
-// Returned addresses are naturally aligned for *T.
+// Returned addresses are naturally aligned for *T.  When T contains pointers
+// the arena is backed by GC‑visible memory and Allocate hands out whole T slots.
 //
 //go:nosplit
 func NewMemoryArena[T any](size int) (Arena[T], error) {
+	a, err := newMemoryArena[T](size)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func newMemoryArena[T any](size int) (*MemoryArena[T], error) {
 	if size <= 0 {
 		return nil, ErrInvalidSize
 	}
 	var dummy T
-	alignment := int(unsafe.Alignof(dummy))
-	alignMask := alignment - 1
-	elemSize := int(unsafe.Sizeof(dummy))
-
-	buf := make([]byte, size+alignment) // +alignment for padding
-	raw := uintptr(unsafe.Pointer(&buf[0]))
-	off := 0
-	if rem := int(raw) & alignMask; rem != 0 {
-		off = alignment - rem
-	}
-	basePtr := unsafe.Pointer(&buf[off])
+	overhead := guardOverhead[T](size)
+	buf, objs, basePtr, usable := newBacking[T](size + overhead)
+	usable -= overhead
 
 	return &MemoryArena[T]{
 		buffer:    buf,
+		objects:   objs,
 		base:      basePtr,
-		size:      size,
+		size:      usable,
+		reserved:  usable,
+		limit:     usable + overhead,
 		offset:    0,
-		alignMask: alignMask,
-		elemSize:  elemSize,
+		alignMask: int(unsafe.Alignof(dummy)) - 1,
+		elemSize:  int(unsafe.Sizeof(dummy)),
+		pointers:  objs != nil,
 	}, nil
 }
 
 func (a *MemoryArena[T]) Allocate(sz int) (unsafe.Pointer, error) {
+	a.stats.allocs++
+	return a.allocate(sz)
+}
+
+// allocate is Allocate without the call counter, shared with NewObject.
+func (a *MemoryArena[T]) allocate(sz int) (unsafe.Pointer, error) {
 	if sz <= 0 {
 		return nil, ErrInvalidSize
 	}
-	off := (a.offset + a.alignMask) &^ a.alignMask
+	if a.pointers {
+		sz = roundSlots(sz, a.elemSize)
+	}
+	if a.instrumented() {
+		return a.allocateGuarded(sz, 0)
+	}
+	off := alignUp(a.offset, a.alignMask)
 	end := off + sz
 	if end > a.size {
+		a.stats.failures++
 		return nil, ErrArenaFull
 	}
+	a.stats.padding += uint64(off - a.offset)
 	a.offset = end
 	return unsafe.Add(a.base, uintptr(off)), nil
 }
 
 // NewObject allocates space for T by calling Allocate, copies `obj` into it, and returns *T.
 func (a *MemoryArena[T]) NewObject(obj T) (*T, error) {
-	ptr, err := a.Allocate(a.elemSize)
+	a.stats.objects++
+	ptr, err := a.allocate(a.elemSize)
 	if err != nil {
 		return nil, err
 	}
@@ -107,82 +148,220 @@ func (a *MemoryArena[T]) NewObject(obj T) (*T, error) {
 }
 
 func (a *MemoryArena[T]) Reset() {
+	atomic.AddUint64(&a.gen, 1) // sub‑arenas of a ConcurrentArena read it unlocked
+	a.retired = nil
+	a.stats.peak = 0
 	if a.offset == 0 {
 		return
 	}
 	if a.zeroBuf == nil {
 		a.zeroBuf = make([]byte, len(a.buffer)) // keep old tests happy
 	}
-	memclrNoHeapPointers(a.base, uintptr(a.offset))
+	a.release(0, a.offset)
 	a.offset = 0
 }
 
+// release zeroes [from, to) – or poisons it in debug builds.
+func (a *MemoryArena[T]) release(from, to int) {
+	if a.instrumented() {
+		a.releaseGuarded(from)
+		return
+	}
+	clearRange[T](a.base, from, to, a.pointers)
+}
+
+// Resize changes the arena's capacity to newSize bytes.  Shrinking, or growing
+// back into memory released by an earlier shrink, happens in place.  Growing
+// past the backing replaces it when the arena is empty; otherwise the current
+// region is retired – its objects stay valid until Reset – and allocation
+// continues at the start of a fresh region of newSize bytes.
+func (a *MemoryArena[T]) Resize(newSize int) error {
+	if newSize <= 0 {
+		return ErrInvalidSize
+	}
+	if newSize < a.offset {
+		return ErrNewSizeTooSmall
+	}
+	if a.pointers {
+		newSize = roundSlots(newSize, a.elemSize)
+	}
+	if newSize <= a.reserved {
+		a.size = newSize
+		return nil
+	}
+	overhead := guardOverhead[T](newSize)
+	buf, objs, base, usable, err := growBacking[T](newSize + overhead)
+	if err != nil {
+		return err
+	}
+	usable -= overhead
+	if a.offset > 0 {
+		a.retired = append(a.retired, region[T]{buffer: a.buffer, objects: a.objects})
+	}
+	a.recordPeak()
+	a.buffer, a.objects, a.base = buf, objs, base
+	a.size, a.reserved, a.offset = usable, usable, 0
+	a.phys, a.limit, a.guards = 0, usable+overhead, nil
+	return nil
+}
+
+// Mark returns a checkpoint of the current allocation offset.
+func (a *MemoryArena[T]) Mark() Mark {
+	return Mark{owner: unsafe.Pointer(a), base: a.base, gen: a.Generation(), offset: a.offset}
+}
+
+// Rewind zeroes everything allocated after m and moves the offset back to it.
+// Marks from another arena, from before a Reset or Resize into a new region,
+// or beyond the current offset are rejected with ErrInvalidMark.
+func (a *MemoryArena[T]) Rewind(m Mark) error {
+	if m.owner != unsafe.Pointer(a) || m.base != a.base || m.gen != a.Generation() || m.offset > a.offset {
+		return ErrInvalidMark
+	}
+	a.recordPeak()
+	a.release(m.offset, a.offset)
+	a.offset = m.offset
+	return nil
+}
+
+// SubArena reserves n bytes from a and returns an arena confined to them.
+// The sub‑arena allocates, rewinds and resets on its own, but the memory still
+// belongs to a: resetting a, or rewinding it past the reservation, invalidates
+// the sub‑arena.  Growing it with Resize beyond n moves it onto its own storage.
+func (a *MemoryArena[T]) SubArena(n int) (Arena[T], error) {
+	if n <= 0 {
+		return nil, ErrInvalidSize
+	}
+	if a.pointers {
+		n = roundSlots(n, a.elemSize)
+	}
+	// Debug builds reserve room for the sub‑arena's guards past the n bytes.
+	var (
+		p     unsafe.Pointer
+		extra int
+		err   error
+	)
+	if a.instrumented() {
+		a.stats.allocs++
+		p, extra, err = a.allocateSub(n)
+	} else {
+		p, err = a.Allocate(n)
+	}
+	if err != nil {
+		return nil, err
+	}
+	sub := &MemoryArena[T]{
+		base:      p,
+		size:      n,
+		reserved:  n,
+		limit:     n + extra,
+		alignMask: a.alignMask,
+		elemSize:  a.elemSize,
+		pointers:  a.pointers,
+		parent:    a,
+	}
+	// Keep only the reserved window of the parent's storage reachable.
+	if a.pointers {
+		i := int(uintptr(p)-uintptr(unsafe.Pointer(&a.objects[0]))) / a.elemSize
+		sub.objects = a.objects[i : i+n/a.elemSize : i+n/a.elemSize]
+	} else {
+		i := int(uintptr(p) - uintptr(unsafe.Pointer(&a.buffer[0])))
+		sub.buffer = a.buffer[i : i+n+extra : i+n+extra]
+	}
+	return sub, nil
+}
+
+// AppendSlice appends elems to slice.  A slice that is the most recent
+// allocation grows in place; any other slice that outgrows its capacity is
+// copied to a fresh block, so earlier allocations are never overwritten.
 func (a *MemoryArena[T]) AppendSlice(slice []T, elems ...T) ([]T, error) {
+	a.stats.appends++
 	if len(elems) == 0 {
 		return slice, nil
 	}
+	if a.instrumented() {
+		return a.appendGuarded(slice, elems...)
+	}
 	need := len(slice) + len(elems)
 
 	// Figure out if `slice` lives in our arena
-	hdr := (*reflect.SliceHeader)(unsafe.Pointer(&slice))
-	ptrData := hdr.Data
+	start := uintptr(unsafe.Pointer(unsafe.SliceData(slice)))
 	arenaStart := uintptr(a.base)
-	arenaEnd := arenaStart + uintptr(a.size)
-	sliceInArena := ptrData >= arenaStart && ptrData < arenaEnd
-
-	// Maximum number of elements we could ever store
-	maxCap := a.size / a.elemSize
-	if need > maxCap {
-		return slice, ErrArenaFull
-	}
+	sliceInArena := cap(slice) > 0 && start >= arenaStart && start < arenaStart+uintptr(a.size)
 
 	if sliceInArena {
 		// 1) In-place grow if there's room
 		if need <= cap(slice) {
-			newSlice := slice[:need]
-			copy(newSlice[len(slice):], elems)
-			return newSlice, nil
+			return append(slice, elems...), nil
 		}
-		// 2) Otherwise, expand the existing block
-		newCap := nextPow2(need)
-		if newCap > maxCap {
-			newCap = maxCap
+		// 2) The slice is the most recent allocation: extend its block in
+		//    place, nothing after it can be overwritten.
+		off := int(start - arenaStart)
+		if off+cap(slice)*a.elemSize == a.offset {
+			newCap := growCap(need, (a.size-off)/a.elemSize)
+			if newCap < need {
+				a.stats.failures++
+				return slice, ErrArenaFull
+			}
+			a.offset = off + newCap*a.elemSize
+			newArr := unsafe.Slice((*T)(unsafe.Add(a.base, off)), newCap)
+			copy(newArr[len(slice):], elems)
+			return newArr[:need], nil
 		}
-		// Compute where the slice starts in the arena
-		offset := ptrData - arenaStart
-		end := int(offset) + newCap*a.elemSize
-		if end > a.size {
-			return slice, ErrArenaFull
-		}
-		// Bump the arena's offset (aligned) to reserve those bytes
-		a.offset = (end + a.alignMask) &^ a.alignMask
-
-		// Build a *new* slice header pointing at the same block, but with bigger cap
-		newArr := unsafe.Slice((*T)(unsafe.Add(a.base, offset)), newCap)
-		// Copy in the fresh elements
-		copy(newArr[len(slice):], elems)
-		return newArr[:need], nil
 	}
 
-	// 3) Slice wasn't from our arena—allocate a fresh chunk
-	newCap := nextPow2(need)
-	if newCap > maxCap {
-		newCap = maxCap
-	}
-	sz := newCap * a.elemSize
-	off := (a.offset + a.alignMask) &^ a.alignMask
-	end := off + sz
-	if end > a.size {
+	// 3) Otherwise move the data to a fresh block
+	off := alignUp(a.offset, a.alignMask)
+	newCap := growCap(need, (a.size-off)/a.elemSize)
+	if newCap < need {
+		a.stats.failures++
 		return slice, ErrArenaFull
 	}
-	a.offset = end
+	a.stats.padding += uint64(off - a.offset)
+	a.offset = off + newCap*a.elemSize
 
-	newArr := unsafe.Slice((*T)(unsafe.Add(a.base, uintptr(off))), newCap)
+	newArr := unsafe.Slice((*T)(unsafe.Add(a.base, off)), newCap)
 	copy(newArr, slice)
 	copy(newArr[len(slice):], elems)
 	return newArr[:need], nil
 }
 
+// Stats returns a snapshot of the arena's allocation counters.
+func (a *MemoryArena[T]) Stats() Stats {
+	a.recordPeak()
+	return Stats{
+		Capacity: a.size,
+		InUse:    a.offset,
+		Peak:     int(a.stats.peak),
+		Allocs:   a.stats.allocs,
+		Objects:  a.stats.objects,
+		Appends:  a.stats.appends,
+		Padding:  a.stats.padding,
+		Failures: a.stats.failures,
+		Resets:   a.gen,
+	}
+}
+
+// recordPeak folds the current offset into the high‑water mark; it must run
+// before anything moves the offset backwards.
+func (a *MemoryArena[T]) recordPeak() {
+	if uint64(a.offset) > a.stats.peak {
+		a.stats.peak = uint64(a.offset)
+	}
+}
+
+// alignUp rounds off up to the next multiple of alignMask+1.
+//
+//go:nosplit
+func alignUp(off, alignMask int) int {
+	return (off + alignMask) &^ alignMask
+}
+
+// growCap returns the capacity for a slice growing to need elements: the
+// next power of two, trimmed to the avail elements the arena has left.
+func growCap(need, avail int) int {
+	return min(nextPow2(need), avail)
+}
+
 //go:nosplit
 func nextPow2(n int) int {
 	if n <= 8 {
@@ -2,6 +2,7 @@ package memoryArena
 
 import (
 	"fmt"
+	"math"
 	"runtime"
 	"sync"
 	"testing"
@@ -70,6 +71,23 @@ func TestAppendSlice_growth(t *testing.T) {
 	}
 }
 
+// A slice that needs a fresh block gets the space left when the next power of
+// two does not fit – in debug builds too.
+func TestAppendSlice_CapacityTrimmedToArena(t *testing.T) {
+	arena, _ := NewMemoryArena[int64](100)
+	arena.NewObject(-1)
+	s, err := arena.AppendSlice(nil, make([]int64, 10)...)
+	if err != nil {
+		t.Fatalf("AppendSlice: %v", err)
+	}
+	if len(s) != 10 || cap(s) != 11 {
+		t.Fatalf("len %d cap %d, want 10 and 11", len(s), cap(s))
+	}
+	if _, err := arena.AppendSlice(s, 1, 2); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+}
+
 // /////////////////////////////////////////////////////////////////////////////
 //                          CONCURRENCY TESTS
 // /////////////////////////////////////////////////////////////////////////////
@@ -251,3 +269,280 @@ func BenchmarkArenaReset8K(b *testing.B) {
 		arena.Reset()
 	}
 }
+
+// -----------------------------------------------------------------------------
+// Resize -----------------------------------------------------------------------
+
+func TestMemoryArena_Resize(t *testing.T) {
+	arena, _ := NewMemoryArena[int](64)
+	r := arena.(Resizer)
+
+	p, _ := arena.NewObject(7)
+	if err := r.Resize(4); err != ErrNewSizeTooSmall {
+		t.Fatalf("shrink below offset: want ErrNewSizeTooSmall, got %v", err)
+	}
+	if err := r.Resize(0); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+
+	// Shrink and grow back in place.
+	if err := r.Resize(16); err != nil {
+		t.Fatalf("shrink: %v", err)
+	}
+	arena.NewObject(8)
+	if _, err := arena.NewObject(9); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull after shrink, got %v", err)
+	}
+	base := arena.Base()
+	if err := r.Resize(64); err != nil || arena.Base() != base {
+		t.Fatalf("grow in place: err=%v moved=%v", err, arena.Base() != base)
+	}
+
+	// Grow past the backing: live objects stay where they are.
+	if err := r.Resize(1024); err != nil {
+		t.Fatalf("grow: %v", err)
+	}
+	for i := 0; i < 100; i++ {
+		if _, err := arena.NewObject(i); err != nil {
+			t.Fatalf("NewObject after grow: %v", err)
+		}
+	}
+	if *p != 7 {
+		t.Fatalf("live object changed after Resize: %d", *p)
+	}
+
+	if err := r.Resize(math.MaxInt / 2); err != ErrOutOfMemory {
+		t.Fatalf("want ErrOutOfMemory, got %v", err)
+	}
+}
+
+func TestMemoryArena_ResizeEmptyReplacesBacking(t *testing.T) {
+	arena, _ := NewMemoryArena[byte](16)
+	if err := arena.(Resizer).Resize(256); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := arena.Allocate(256); err != nil {
+		t.Fatalf("Allocate after Resize: %v", err)
+	}
+	if ma := arena.(*MemoryArena[byte]); len(ma.retired) != 0 {
+		t.Fatalf("empty arena should not retire its backing")
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Mark / Rewind ----------------------------------------------------------------
+
+func TestMemoryArena_MarkRewind(t *testing.T) {
+	arena, _ := NewMemoryArena[int](256)
+	r := arena.(Rewinder)
+
+	keep, _ := arena.NewObject(1)
+	m := r.Mark()
+	for i := 0; i < 4; i++ {
+		arena.NewObject(100 + i)
+	}
+	if err := r.Rewind(m); err != nil {
+		t.Fatalf("Rewind: %v", err)
+	}
+	if arena.Offset() != m.offset {
+		t.Fatalf("offset %d, want %d", arena.Offset(), m.offset)
+	}
+	p, _ := arena.NewObject(0)
+	if *p != 0 || *keep != 1 {
+		t.Fatalf("rewound memory not zeroed or earlier object lost: %d %d", *p, *keep)
+	}
+	// Rewinding to the same mark twice is fine.
+	if err := r.Rewind(m); err != nil {
+		t.Fatalf("second Rewind: %v", err)
+	}
+}
+
+func TestMemoryArena_RewindRejectsInvalidMarks(t *testing.T) {
+	a1, _ := NewMemoryArena[int](256)
+	a2, _ := NewMemoryArena[int](256)
+
+	if err := a1.(Rewinder).Rewind(Mark{}); err != ErrInvalidMark {
+		t.Fatalf("zero mark: want ErrInvalidMark, got %v", err)
+	}
+	if err := a1.(Rewinder).Rewind(a2.(Rewinder).Mark()); err != ErrInvalidMark {
+		t.Fatalf("foreign mark: want ErrInvalidMark, got %v", err)
+	}
+
+	a1.NewObject(1)
+	m := a1.(Rewinder).Mark()
+	a1.Reset()
+	a1.NewObject(2)
+	a1.NewObject(3)
+	if err := a1.(Rewinder).Rewind(m); err != ErrInvalidMark {
+		t.Fatalf("mark from before Reset: want ErrInvalidMark, got %v", err)
+	}
+
+	early := a1.(Rewinder).Mark()
+	a1.NewObject(4)
+	late := a1.(Rewinder).Mark()
+	a1.(Rewinder).Rewind(early)
+	if err := a1.(Rewinder).Rewind(late); err != ErrInvalidMark {
+		t.Fatalf("mark beyond offset: want ErrInvalidMark, got %v", err)
+	}
+}
+
+func TestMemoryArena_RewindPointers(t *testing.T) {
+	arena, _ := NewMemoryArena[string](256)
+	m := arena.(Rewinder).Mark()
+	arena.NewObject("speculative")
+	if err := arena.(Rewinder).Rewind(m); err != nil {
+		t.Fatal(err)
+	}
+	p, _ := arena.NewObject("")
+	if *p != "" {
+		t.Fatalf("rewound slot not cleared: %q", *p)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// SubArena ---------------------------------------------------------------------
+
+func TestMemoryArena_SubArena(t *testing.T) {
+	parent, _ := NewMemoryArena[int](1024)
+	pa := parent.(*MemoryArena[int])
+	before, _ := parent.NewObject(-1)
+
+	sub, err := pa.SubArena(64)
+	if err != nil {
+		t.Fatalf("SubArena: %v", err)
+	}
+	after, _ := parent.NewObject(-2)
+
+	for i := 0; i < 8; i++ {
+		if _, err := sub.NewObject(i); err != nil {
+			t.Fatalf("sub NewObject %d: %v", i, err)
+		}
+	}
+	if _, err := sub.NewObject(8); err != ErrArenaFull {
+		t.Fatalf("sub-arena exceeded its region: %v", err)
+	}
+	start := uintptr(sub.Base())
+	if start < uintptr(parent.Base()) || start+64 > uintptr(unsafe.Pointer(after)) {
+		t.Fatalf("sub-arena region not inside parent reservation")
+	}
+
+	sub.Reset()
+	if sub.Offset() != 0 || *before != -1 || *after != -2 {
+		t.Fatalf("sub Reset touched parent memory: before=%d after=%d", *before, *after)
+	}
+	s, err := sub.AppendSlice(nil, 1, 2, 3)
+	if err != nil || len(s) != 3 {
+		t.Fatalf("sub AppendSlice: %v %v", s, err)
+	}
+}
+
+func TestMemoryArena_SubArenaErrors(t *testing.T) {
+	parent, _ := NewMemoryArena[int](64)
+	pa := parent.(*MemoryArena[int])
+	if _, err := pa.SubArena(0); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+	if _, err := pa.SubArena(128); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+}
+
+func TestMemoryArena_SubArenaPointers(t *testing.T) {
+	parent, _ := NewMemoryArena[person](64 * int(unsafe.Sizeof(person{})))
+	sub, err := parent.(*MemoryArena[person]).SubArena(10 * int(unsafe.Sizeof(person{})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, _ := sub.NewObject(newPerson(3))
+	churnHeap()
+	checkPerson(t, p, 3)
+}
+
+func TestAppendSlice_InterleavedAllocations(t *testing.T) {
+	arenas := map[string]func(int) (Arena[int], error){
+		"MemoryArena":     NewMemoryArena[int],
+		"AtomicArena":     NewAtomicArena[int],
+		"ConcurrentArena": NewConcurrentArena[int],
+	}
+	for name, newArena := range arenas {
+		t.Run(name, func(t *testing.T) {
+			a, _ := newArena(1024)
+
+			// Top of the arena: the block is extended, not moved.
+			s, _ := a.AppendSlice(nil, 1)
+			first := &s[0]
+			s, err := a.AppendSlice(s, 2, 3, 4, 5, 6, 7, 8, 9)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if &s[0] != first || cap(s) != 16 || a.Offset() != 16*8 {
+				t.Fatalf("top slice not grown in place: moved=%v cap=%d offset=%d",
+					&s[0] != first, cap(s), a.Offset())
+			}
+
+			// Something allocated after the slice: it must be relocated.
+			x, _ := a.NewObject(99)
+			before := a.Offset()
+			s, err = a.AppendSlice(s, make([]int, 8)...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *x != 99 {
+				t.Fatalf("AppendSlice overwrote a later allocation: %d", *x)
+			}
+			if &s[0] == first || a.Offset() <= before {
+				t.Fatalf("slice not relocated: offset %d -> %d", before, a.Offset())
+			}
+			for i := 0; i < 9; i++ {
+				if s[i] != i+1 {
+					t.Fatalf("s[%d] = %d after relocation", i, s[i])
+				}
+			}
+
+			// A stale prefix of an older block never moves the offset back.
+			old := unsafe.Slice(first, 16)[:1:8]
+			before = a.Offset()
+			if _, err := a.AppendSlice(old, make([]int, 8)...); err != nil {
+				t.Fatal(err)
+			}
+			if a.Offset() <= before || *x != 99 {
+				t.Fatalf("offset moved from %d to %d", before, a.Offset())
+			}
+		})
+	}
+}
+
+func TestAtomicArena_AppendSliceConcurrent(t *testing.T) {
+	a, _ := NewAtomicArena[int](1 << 20)
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			var s []int
+			var objs []*int
+			for i := 0; i < 200; i++ {
+				var err error
+				if s, err = a.AppendSlice(s, w*1000+i); err != nil {
+					t.Errorf("AppendSlice: %v", err)
+					return
+				}
+				p, _ := a.NewObject(-w)
+				objs = append(objs, p)
+			}
+			for i, v := range s {
+				if v != w*1000+i {
+					t.Errorf("worker %d: s[%d] = %d", w, i, v)
+					return
+				}
+			}
+			for _, p := range objs {
+				if *p != -w {
+					t.Errorf("worker %d: object overwritten: %d", w, *p)
+					return
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+}
@@ -0,0 +1,121 @@
+package memoryArena
+
+import (
+	"net/http"
+	"sync"
+)
+
+// DefaultRequestArenaSize is the arena capacity a request gets when the
+// middleware has no SizePolicy or the policy returns a size <= 0.
+const DefaultRequestArenaSize = 64 << 10
+
+// SizePolicy picks the arena capacity, in bytes, for a request.
+type SizePolicy func(r *http.Request) int
+
+// FixedSize gives every request an arena of n bytes.
+func FixedSize(n int) SizePolicy {
+	return func(*http.Request) int { return n }
+}
+
+// ContentLengthSize sizes the arena after the request body: its declared
+// length clamped to [lo, hi], or lo when the length is unknown.
+func ContentLengthSize(lo, hi int) SizePolicy {
+	return func(r *http.Request) int {
+		n := int(r.ContentLength)
+		if n < lo {
+			return lo
+		}
+		if n > hi {
+			return hi
+		}
+		return n
+	}
+}
+
+// MiddlewareOptions configures ArenaMiddleware.
+type MiddlewareOptions struct {
+	Size       SizePolicy // arena capacity per request; nil means DefaultRequestArenaSize
+	Concurrent bool       // use ConcurrentArena, for handlers that allocate from several goroutines
+	// OnStats, when set, receives each request's arena statistics after the
+	// handler returns or panics.  Counters cover that request only; Peak is
+	// the highest offset it reached and Failures counts ErrArenaFull results.
+	OnStats func(r *http.Request, s Stats)
+}
+
+// ArenaMiddleware returns middleware that gives every request its own
+// Arena[T], reachable from handlers with FromContext[T](r.Context()).  The
+// arena is Reset and returned to a pool once the handler returns, also when
+// it panics, so nothing allocated from it may outlive the request.
+//
+// Sizes chosen by the policy are rounded up to a power of two and arenas are
+// pooled per rounded size with ArenaScope.
+func ArenaMiddleware[T any](opts MiddlewareOptions) func(http.Handler) http.Handler {
+	m := &arenaMiddleware[T]{opts: opts}
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			m.serve(next, w, r)
+		})
+	}
+}
+
+type arenaMiddleware[T any] struct {
+	opts   MiddlewareOptions
+	scopes sync.Map // rounded size → *ArenaScope[T]
+}
+
+func (m *arenaMiddleware[T]) scope(r *http.Request) (*ArenaScope[T], error) {
+	size := 0
+	if m.opts.Size != nil {
+		size = m.opts.Size(r)
+	}
+	if size <= 0 {
+		size = DefaultRequestArenaSize
+	}
+	size = nextPow2(size)
+	if s, ok := m.scopes.Load(size); ok {
+		return s.(*ArenaScope[T]), nil
+	}
+	s, err := NewArenaScope[T](size, m.opts.Concurrent)
+	if err != nil {
+		return nil, err
+	}
+	actual, _ := m.scopes.LoadOrStore(size, s)
+	return actual.(*ArenaScope[T]), nil
+}
+
+func (m *arenaMiddleware[T]) serve(next http.Handler, w http.ResponseWriter, r *http.Request) {
+	s, err := m.scope(r)
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	a, err := s.Acquire()
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusServiceUnavailable), http.StatusServiceUnavailable)
+		return
+	}
+	sr, _ := a.(StatsReporter)
+	var before Stats
+	if m.opts.OnStats != nil && sr != nil {
+		before = sr.Stats()
+	}
+	defer func() {
+		if m.opts.OnStats != nil && sr != nil {
+			m.opts.OnStats(r, statsSince(sr.Stats(), before))
+		}
+		s.Release(a)
+	}()
+	next.ServeHTTP(w, r.WithContext(WithArena(r.Context(), a)))
+}
+
+// statsSince returns the counters of s accumulated after the snapshot prev
+// of the same arena.  Gauges (Capacity, InUse, Peak) are taken from s.
+func statsSince(s, prev Stats) Stats {
+	s.Allocs -= prev.Allocs
+	s.Objects -= prev.Objects
+	s.Appends -= prev.Appends
+	s.Padding -= prev.Padding
+	s.Failures -= prev.Failures
+	s.Resets -= prev.Resets
+	return s
+}
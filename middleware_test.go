@@ -0,0 +1,136 @@
+package memoryArena
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestArenaMiddleware_ProvidesArena(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		stats []Stats
+	)
+	mw := ArenaMiddleware[int64](MiddlewareOptions{
+		Size: FixedSize(1000),
+		OnStats: func(r *http.Request, s Stats) {
+			mu.Lock()
+			stats = append(stats, s)
+			mu.Unlock()
+		},
+	})
+	h := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, ok := FromContext[int64](r.Context())
+		if !ok {
+			t.Error("no arena in request context")
+			return
+		}
+		if a.Offset() != 0 {
+			t.Errorf("request got a dirty arena: offset %d", a.Offset())
+		}
+		for i := 0; i < 10; i++ {
+			a.NewObject(int64(i))
+		}
+		a.Allocate(4096) // too big for a 1024‑byte arena
+		w.WriteHeader(http.StatusNoContent)
+	}))
+
+	for i := 0; i < 3; i++ {
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, httptest.NewRequest("GET", "/", nil))
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("status %d", rec.Code)
+		}
+	}
+	if len(stats) != 3 {
+		t.Fatalf("OnStats called %d times, want 3", len(stats))
+	}
+	for _, s := range stats {
+		if s.Capacity != 1024 || s.Peak != 80 || s.Objects != 10 || s.Allocs != 1 || s.Failures != 1 {
+			t.Fatalf("per‑request stats %+v", s)
+		}
+	}
+}
+
+func TestArenaMiddleware_ResetOnPanic(t *testing.T) {
+	var arena Arena[byte]
+	var got Stats
+	mw := ArenaMiddleware[byte](MiddlewareOptions{
+		OnStats: func(r *http.Request, s Stats) { got = s },
+	})
+	h := mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		arena, _ = FromContext[byte](r.Context())
+		arena.Allocate(100)
+		panic("handler failed")
+	}))
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Fatal("panic was swallowed")
+			}
+		}()
+		h.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil))
+	}()
+	if arena.Offset() != 0 {
+		t.Fatalf("arena not reset after panic: offset %d", arena.Offset())
+	}
+	if got.Peak != 100 || got.Capacity != DefaultRequestArenaSize {
+		t.Fatalf("stats after panic %+v", got)
+	}
+}
+
+func TestArenaMiddleware_SizePolicy(t *testing.T) {
+	p := ContentLengthSize(256, 4096)
+	for _, tc := range []struct {
+		body string
+		want int
+	}{
+		{"", 256},
+		{strings.Repeat("x", 1000), 1000},
+		{strings.Repeat("x", 9000), 4096},
+	} {
+		r := httptest.NewRequest("POST", "/", strings.NewReader(tc.body))
+		if got := p(r); got != tc.want {
+			t.Fatalf("body %d bytes: size %d, want %d", len(tc.body), got, tc.want)
+		}
+	}
+
+	var capacity int
+	mw := ArenaMiddleware[byte](MiddlewareOptions{
+		Size:       p,
+		Concurrent: true,
+		OnStats:    func(r *http.Request, s Stats) { capacity = s.Capacity },
+	})
+	srv := httptest.NewServer(mw(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := FromContext[byte](r.Context())
+		if _, ok := a.(*ConcurrentArena[byte]); !ok {
+			t.Errorf("Concurrent option ignored: %T", a)
+		}
+	})))
+	defer srv.Close()
+	resp, err := http.Post(srv.URL, "text/plain", strings.NewReader(strings.Repeat("x", 1000)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if capacity != 1024 {
+		t.Fatalf("arena capacity %d, want 1000 rounded up to 1024", capacity)
+	}
+}
+
+func BenchmarkArenaMiddleware(b *testing.B) {
+	h := ArenaMiddleware[int](MiddlewareOptions{})(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		a, _ := FromContext[int](r.Context())
+		for i := 0; i < 64; i++ {
+			a.NewObject(i)
+		}
+	}))
+	req := httptest.NewRequest("GET", "/", nil)
+	rec := httptest.NewRecorder()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		h.ServeHTTP(rec, req)
+	}
+}
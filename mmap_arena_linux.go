@@ -0,0 +1,127 @@
+//go:build linux
+
+package memoryArena
+
+import (
+	"syscall"
+	"unsafe"
+)
+
+// MmapArena is a bump‑allocator whose storage is mapped straight from the OS
+// with mmap.  The memory lives outside the Go heap: it does not count towards
+// GOGC pacing, and Release hands it back to the kernel immediately instead of
+// waiting for the arena to become unreachable.
+//
+// Because the GC never sees the mapping, T must not contain pointers.
+// Like MemoryArena it is NOT goroutine‑safe.
+type MmapArena[T any] struct {
+	arena    *MemoryArena[T] // bump logic over the mapping
+	mem      []byte          // the whole mapping; nil once released
+	page     int             // system page size
+	dontNeed bool            // Reset drops resident pages with MADV_DONTNEED
+}
+
+// NewMmapArena maps at least `size` bytes for T.  With dontNeedOnReset set,
+// Reset returns the used pages to the OS (MADV_DONTNEED) instead of zeroing
+// them; they are faulted back in, zero‑filled, on next use.
+func NewMmapArena[T any](size int, dontNeedOnReset bool) (*MmapArena[T], error) {
+	if size <= 0 {
+		return nil, ErrInvalidSize
+	}
+	if hasPointers[T]() {
+		return nil, ErrInvalidType
+	}
+	page := syscall.Getpagesize()
+	limit := size + guardOverhead[T](size)
+	mem, err := syscall.Mmap(-1, 0, alignUp(limit, page-1),
+		syscall.PROT_READ|syscall.PROT_WRITE, syscall.MAP_PRIVATE|syscall.MAP_ANON)
+	if err != nil {
+		return nil, ErrOutOfMemory
+	}
+	var dummy T
+	return &MmapArena[T]{
+		arena: &MemoryArena[T]{
+			base:      unsafe.Pointer(&mem[0]), // page aligned
+			size:      size,
+			reserved:  size,
+			limit:     limit,
+			alignMask: int(unsafe.Alignof(dummy)) - 1,
+			elemSize:  int(unsafe.Sizeof(dummy)),
+			zeroBuf:   []byte{}, // never mirror the mapping on the heap
+		},
+		mem:      mem,
+		page:     page,
+		dontNeed: dontNeedOnReset,
+	}, nil
+}
+
+func (m *MmapArena[T]) Allocate(sz int) (unsafe.Pointer, error) {
+	if m.mem == nil {
+		return nil, ErrArenaReleased
+	}
+	return m.arena.Allocate(sz)
+}
+
+func (m *MmapArena[T]) NewObject(obj T) (*T, error) {
+	if m.mem == nil {
+		return nil, ErrArenaReleased
+	}
+	return m.arena.NewObject(obj)
+}
+
+func (m *MmapArena[T]) AppendSlice(slice []T, elems ...T) ([]T, error) {
+	if m.mem == nil {
+		return slice, ErrArenaReleased
+	}
+	return m.arena.AppendSlice(slice, elems...)
+}
+
+// Reset releases all allocations.  Used pages are either zeroed in place or,
+// with dontNeedOnReset, dropped from the resident set.
+func (m *MmapArena[T]) Reset() {
+	if m.mem == nil {
+		return
+	}
+	if m.dontNeed && m.arena.offset > 0 {
+		used := alignUp(max(m.arena.offset, m.arena.phys), m.page-1)
+		if syscall.Madvise(m.mem[:used], syscall.MADV_DONTNEED) == nil {
+			m.arena.offset, m.arena.phys, m.arena.guards = 0, 0, nil
+		}
+	}
+	m.arena.Reset()
+}
+
+// Release unmaps the arena's memory.  Every pointer handed out by the arena
+// becomes invalid; further allocations fail with ErrArenaReleased.
+func (m *MmapArena[T]) Release() error {
+	if m.mem == nil {
+		return nil
+	}
+	err := syscall.Munmap(m.mem)
+	m.mem = nil
+	m.arena.base, m.arena.size, m.arena.offset = nil, 0, 0
+	return err
+}
+
+// Close implements io.Closer by calling Release.
+func (m *MmapArena[T]) Close() error {
+	return m.Release()
+}
+
+func (m *MmapArena[T]) Offset() int {
+	return m.arena.offset
+}
+
+func (m *MmapArena[T]) Base() unsafe.Pointer {
+	return m.arena.base
+}
+
+// Stats returns a snapshot of the arena's allocation counters.
+func (m *MmapArena[T]) Stats() Stats {
+	return m.arena.Stats()
+}
+
+// Capacity returns the usable size of the arena in bytes, 0 once released.
+func (m *MmapArena[T]) Capacity() int {
+	return m.arena.size
+}
@@ -0,0 +1,96 @@
+//go:build linux
+
+package memoryArena
+
+import (
+	"runtime"
+	"testing"
+	"unsafe"
+)
+
+var _ Arena[int] = (*MmapArena[int])(nil)
+
+func TestNewMmapArena_Errors(t *testing.T) {
+	if _, err := NewMmapArena[int](0, false); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+	if _, err := NewMmapArena[person](1024, false); err != ErrInvalidType {
+		t.Fatalf("want ErrInvalidType for pointer-bearing T, got %v", err)
+	}
+}
+
+func TestMmapArena_Basic(t *testing.T) {
+	m, err := NewMmapArena[point](4096, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Close()
+
+	p, err := m.NewObject(point{3, 4})
+	if err != nil || p.X != 3 || p.Y != 4 {
+		t.Fatalf("NewObject: %v %+v", err, p)
+	}
+	s, err := m.AppendSlice(nil, point{1, 1}, point{2, 2})
+	if err != nil || len(s) != 2 || s[1].Y != 2 {
+		t.Fatalf("AppendSlice: %v %v", err, s)
+	}
+	if _, err := m.Allocate(8192); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+}
+
+func TestMmapArena_ResetZeroes(t *testing.T) {
+	for _, dontNeed := range []bool{false, true} {
+		m, _ := NewMmapArena[byte](3*4096, dontNeed)
+		ptr, _ := m.Allocate(5000)
+		b := unsafe.Slice((*byte)(ptr), 5000)
+		for i := range b {
+			b[i] = 0xAA
+		}
+		m.Reset()
+		if m.Offset() != 0 {
+			t.Fatalf("dontNeed=%v: offset %d after Reset", dontNeed, m.Offset())
+		}
+		ptr, _ = m.Allocate(5000)
+		for i, v := range unsafe.Slice((*byte)(ptr), 5000) {
+			if v != 0 {
+				t.Fatalf("dontNeed=%v: byte %d not zero after Reset", dontNeed, i)
+			}
+		}
+		m.Release()
+	}
+}
+
+func TestMmapArena_Release(t *testing.T) {
+	m, _ := NewMmapArena[int](1024, false)
+	m.NewObject(1)
+	if err := m.Release(); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	if err := m.Close(); err != nil {
+		t.Fatalf("second Release: %v", err)
+	}
+	if _, err := m.NewObject(2); err != ErrArenaReleased {
+		t.Fatalf("want ErrArenaReleased, got %v", err)
+	}
+	if m.Capacity() != 0 || m.Base() != nil {
+		t.Fatalf("released arena still reports memory")
+	}
+	m.Reset() // must not fault
+}
+
+func TestMmapArena_OffHeap(t *testing.T) {
+	const size = 64 << 20
+	var before, after runtime.MemStats
+	runtime.GC()
+	runtime.ReadMemStats(&before)
+	m, err := NewMmapArena[byte](size, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Release()
+	runtime.ReadMemStats(&after)
+	if after.HeapAlloc > before.HeapAlloc+size/2 {
+		t.Fatalf("mapping counted against the Go heap: %d -> %d", before.HeapAlloc, after.HeapAlloc)
+	}
+}
@@ -0,0 +1,225 @@
+package memoryArena
+
+import (
+	"encoding/binary"
+	"sync/atomic"
+	"unsafe"
+)
+
+// PoolArena hands out fixed‑size T slots from a MemoryArena buffer and lets
+// individual objects be returned with Free.  Freed slots are kept on a free
+// list – for pointer‑free T the link lives in the slot itself – and are reused
+// by NewObject before the arena is bumped any further, so long‑lived collections
+// with churn stop growing towards ErrArenaFull.
+// Like MemoryArena it is NOT goroutine‑safe; see AtomicPoolArena.
+type PoolArena[T any] struct {
+	arena    *MemoryArena[T]
+	slotSize int      // bytes per slot: sizeof(T), at least room for a link
+	free     uint32   // index+1 of the first free slot; 0 when the list is empty
+	live     int      // objects handed out and not freed
+	next     []uint32 // side links when T holds pointers, see NewPoolArena
+}
+
+// linkSize is the room a free slot needs for its link.
+const linkSize = 4
+
+// NewPoolArena allocates a pool with at least `size` bytes of slot storage.
+func NewPoolArena[T any](size int) (*PoolArena[T], error) {
+	var dummy T
+	elemSize := int(unsafe.Sizeof(dummy))
+	if elemSize == 0 {
+		return nil, ErrInvalidType
+	}
+	a, err := newMemoryArena[T](size)
+	if err != nil {
+		return nil, err
+	}
+	slotSize := alignUp(elemSize, a.alignMask)
+	if slotSize < linkSize {
+		slotSize = linkSize
+	}
+	p := &PoolArena[T]{arena: a, slotSize: slotSize}
+	if a.pointers {
+		// A link in a GC‑scanned word could look like a heap pointer, so
+		// pointer‑bearing slots keep their links aside, as AtomicPoolArena does.
+		p.next = make([]uint32, a.size/slotSize)
+	}
+	return p, nil
+}
+
+func (p *PoolArena[T]) slot(i uint32) unsafe.Pointer {
+	return unsafe.Add(p.arena.base, int(i)*p.slotSize)
+}
+
+// link returns the free‑list link of free slot i.
+func (p *PoolArena[T]) link(i uint32) uint32 {
+	if p.next != nil {
+		return p.next[i]
+	}
+	return binary.NativeEndian.Uint32(unsafe.Slice((*byte)(p.slot(i)), linkSize))
+}
+
+// setLink stores the free‑list link of slot i.
+func (p *PoolArena[T]) setLink(i, next uint32) {
+	if p.next != nil {
+		p.next[i] = next
+		return
+	}
+	binary.NativeEndian.PutUint32(unsafe.Slice((*byte)(p.slot(i)), linkSize), next)
+}
+
+// NewObject copies obj into a free slot – reusing freed ones first – and
+// returns a pointer to it.
+func (p *PoolArena[T]) NewObject(obj T) (*T, error) {
+	var ptr unsafe.Pointer
+	if p.free != 0 {
+		i := p.free - 1
+		p.free = p.link(i)
+		p.setLink(i, 0)
+		ptr = p.slot(i)
+	} else {
+		// Bump directly: slots must stay contiguous for Free's index math.
+		a := p.arena
+		if a.offset+p.slotSize > a.size {
+			return nil, ErrArenaFull
+		}
+		ptr = unsafe.Add(a.base, a.offset)
+		a.offset += p.slotSize
+	}
+	p.live++
+	r := (*T)(ptr)
+	*r = obj
+	return r, nil
+}
+
+// Free zeroes *x and returns its slot to the pool.  x must come from
+// NewObject on this pool and must not be freed twice.
+func (p *PoolArena[T]) Free(x *T) error {
+	off := int(uintptr(unsafe.Pointer(x)) - uintptr(p.arena.base))
+	if x == nil || off < 0 || off >= p.arena.offset || off%p.slotSize != 0 {
+		return ErrInvalidPointer
+	}
+	var zero T
+	*x = zero
+	i := uint32(off / p.slotSize)
+	p.setLink(i, p.free)
+	p.free = i + 1
+	p.live--
+	return nil
+}
+
+// Reset releases every slot at once.
+func (p *PoolArena[T]) Reset() {
+	p.arena.Reset()
+	p.free, p.live = 0, 0
+}
+
+// Len returns the number of live objects.
+func (p *PoolArena[T]) Len() int {
+	return p.live
+}
+
+// Cap returns the number of slots the pool can hold.
+func (p *PoolArena[T]) Cap() int {
+	return p.arena.size / p.slotSize
+}
+
+// AtomicPoolArena is the lock‑free counterpart of PoolArena, built in the
+// AtomicArena CAS style.  Its free list is a Treiber stack whose head carries
+// an ABA tag; links live in a side array rather than in the slots, so a slot
+// being reused never races with a concurrent pop reading its link.
+// Note: Reset is not concurrency‑safe and should be called when no allocations are in flight.
+type AtomicPoolArena[T any] struct {
+	head  uint64 // tag<<32 | index+1 of the first free slot (atomic)
+	live  int64  // objects handed out and not freed (atomic)
+	arena *AtomicArena[T]
+	next  []uint32 // free‑list links, index+1 (atomic)
+}
+
+// NewAtomicPoolArena allocates a lock‑free pool with at least `size` bytes
+// of slot storage.
+func NewAtomicPoolArena[T any](size int) (*AtomicPoolArena[T], error) {
+	var dummy T
+	if unsafe.Sizeof(dummy) == 0 {
+		return nil, ErrInvalidType
+	}
+	a, err := NewAtomicArena[T](size)
+	if err != nil {
+		return nil, err
+	}
+	aa := a.(*AtomicArena[T])
+	return &AtomicPoolArena[T]{
+		arena: aa,
+		next:  make([]uint32, aa.size/aa.elemSize),
+	}, nil
+}
+
+func (p *AtomicPoolArena[T]) slot(i uint32) unsafe.Pointer {
+	return unsafe.Add(p.arena.base, uintptr(i)*p.arena.elemSize)
+}
+
+// NewObject copies obj into a free slot – reusing freed ones first – and
+// returns a pointer to it.  Safe for concurrent use.
+func (p *AtomicPoolArena[T]) NewObject(obj T) (*T, error) {
+	var ptr unsafe.Pointer
+	for {
+		h := atomic.LoadUint64(&p.head)
+		top := uint32(h)
+		if top == 0 {
+			break
+		}
+		next := atomic.LoadUint32(&p.next[top-1])
+		if atomic.CompareAndSwapUint64(&p.head, h, (h>>32+1)<<32|uint64(next)) {
+			ptr = p.slot(top - 1)
+			break
+		}
+	}
+	if ptr == nil {
+		var err error
+		if ptr, err = p.arena.allocate(int(p.arena.elemSize)); err != nil {
+			return nil, err
+		}
+	}
+	atomic.AddInt64(&p.live, 1)
+	r := (*T)(ptr)
+	*r = obj
+	return r, nil
+}
+
+// Free zeroes *x and returns its slot to the pool.  Safe for concurrent use;
+// x must come from NewObject on this pool and must not be freed twice.
+func (p *AtomicPoolArena[T]) Free(x *T) error {
+	off := uintptr(unsafe.Pointer(x)) - uintptr(p.arena.base)
+	if x == nil || off >= uintptr(atomic.LoadUint64(&p.arena.offset)) || off%p.arena.elemSize != 0 {
+		return ErrInvalidPointer
+	}
+	var zero T
+	*x = zero
+	i := uint32(off / p.arena.elemSize)
+	for {
+		h := atomic.LoadUint64(&p.head)
+		atomic.StoreUint32(&p.next[i], uint32(h))
+		if atomic.CompareAndSwapUint64(&p.head, h, (h>>32+1)<<32|uint64(i+1)) {
+			atomic.AddInt64(&p.live, -1)
+			return nil
+		}
+	}
+}
+
+// Reset releases every slot at once.  Not safe to call concurrently with
+// NewObject or Free.
+func (p *AtomicPoolArena[T]) Reset() {
+	p.arena.Reset()
+	atomic.StoreUint64(&p.head, 0)
+	atomic.StoreInt64(&p.live, 0)
+}
+
+// Len returns the number of live objects.
+func (p *AtomicPoolArena[T]) Len() int {
+	return int(atomic.LoadInt64(&p.live))
+}
+
+// Cap returns the number of slots the pool can hold.
+func (p *AtomicPoolArena[T]) Cap() int {
+	return len(p.next)
+}
@@ -0,0 +1,210 @@
+package memoryArena
+
+import (
+	"sync"
+	"testing"
+	"unsafe"
+)
+
+func TestPoolArena_FreeReusesSlot(t *testing.T) {
+	p, err := NewPoolArena[point](4 * int(unsafe.Sizeof(point{})))
+	if err != nil {
+		t.Fatal(err)
+	}
+	objs := make([]*point, 4)
+	for i := range objs {
+		if objs[i], err = p.NewObject(point{i, i}); err != nil {
+			t.Fatalf("NewObject %d: %v", i, err)
+		}
+	}
+	if _, err := p.NewObject(point{}); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+
+	p.Free(objs[1])
+	p.Free(objs[3])
+	if *objs[1] != (point{}) {
+		t.Fatalf("Free did not zero the object: %+v", *objs[1])
+	}
+	if p.Len() != 2 || p.Cap() != 4 {
+		t.Fatalf("Len/Cap = %d/%d, want 2/4", p.Len(), p.Cap())
+	}
+
+	// LIFO reuse of the freed slots, then full again.
+	a, _ := p.NewObject(point{7, 7})
+	b, _ := p.NewObject(point{8, 8})
+	if a != objs[3] || b != objs[1] {
+		t.Fatalf("freed slots not reused")
+	}
+	if *objs[0] != (point{0, 0}) || *objs[2] != (point{2, 2}) {
+		t.Fatalf("live objects clobbered by free list")
+	}
+	if _, err := p.NewObject(point{}); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+}
+
+func TestPoolArena_SmallTypes(t *testing.T) {
+	p, _ := NewPoolArena[byte](16)
+	if p.Cap() != 4 {
+		t.Fatalf("byte slots should be widened to hold a link: cap %d", p.Cap())
+	}
+	x, _ := p.NewObject(1)
+	y, _ := p.NewObject(2)
+	p.Free(x)
+	z, _ := p.NewObject(3)
+	if z != x || *y != 2 || *z != 3 {
+		t.Fatalf("unexpected reuse: %d %d", *y, *z)
+	}
+}
+
+func TestPoolArena_InvalidFree(t *testing.T) {
+	p, _ := NewPoolArena[point](256)
+	x, _ := p.NewObject(point{1, 1})
+	if err := p.Free(&point{}); err != ErrInvalidPointer {
+		t.Fatalf("foreign pointer: want ErrInvalidPointer, got %v", err)
+	}
+	if err := p.Free((*point)(unsafe.Add(unsafe.Pointer(x), 8))); err != ErrInvalidPointer {
+		t.Fatalf("interior pointer: want ErrInvalidPointer, got %v", err)
+	}
+	if err := p.Free(nil); err != ErrInvalidPointer {
+		t.Fatalf("nil: want ErrInvalidPointer, got %v", err)
+	}
+	if _, err := NewPoolArena[struct{}](64); err != ErrInvalidType {
+		t.Fatalf("zero-size T: want ErrInvalidType, got %v", err)
+	}
+}
+
+func TestPoolArena_Reset(t *testing.T) {
+	p, _ := NewPoolArena[int](64)
+	x, _ := p.NewObject(1)
+	p.NewObject(2)
+	p.Free(x)
+	p.Reset()
+	if p.Len() != 0 {
+		t.Fatalf("Len %d after Reset", p.Len())
+	}
+	for i := 0; i < p.Cap(); i++ {
+		if v, err := p.NewObject(0); err != nil || *v != 0 {
+			t.Fatalf("slot %d after Reset: %v %d", i, err, *v)
+		}
+	}
+}
+
+func TestPoolArena_KeepsPointersAlive(t *testing.T) {
+	p, _ := NewPoolArena[person](8 * int(unsafe.Sizeof(person{})))
+	objs := make([]*person, 8)
+	for i := range objs {
+		objs[i], _ = p.NewObject(newPerson(i))
+	}
+	for i := 0; i < 8; i += 2 {
+		p.Free(objs[i])
+		objs[i], _ = p.NewObject(newPerson(100 + i))
+	}
+	churnHeap()
+	for i, o := range objs {
+		if i%2 == 0 {
+			checkPerson(t, o, 100+i)
+		} else {
+			checkPerson(t, o, i)
+		}
+	}
+}
+
+func TestPoolArena_PointerSlotsHoldNoLinks(t *testing.T) {
+	p, _ := NewPoolArena[*int](8 * 8)
+	objs := make([]**int, 4)
+	for i := range objs {
+		objs[i], _ = p.NewObject(new(int))
+	}
+	p.Free(objs[0])
+	p.Free(objs[2])
+	// GC‑scanned words of free slots must stay nil, not hold free‑list links.
+	if *objs[0] != nil || *objs[2] != nil {
+		t.Fatalf("free slot holds %p %p", *objs[0], *objs[2])
+	}
+	a, _ := p.NewObject(nil)
+	b, _ := p.NewObject(nil)
+	if a != objs[2] || b != objs[0] {
+		t.Fatalf("freed pointer slots not reused")
+	}
+}
+
+func TestAtomicPoolArena_ConcurrentChurn(t *testing.T) {
+	const workers, slots = 8, 64
+	p, _ := NewAtomicPoolArena[uint64](slots * 8)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w uint64) {
+			defer wg.Done()
+			for i := uint64(0); i < 2000; i++ {
+				x, err := p.NewObject(w<<32 | i)
+				if err == ErrArenaFull {
+					continue
+				}
+				if err != nil {
+					t.Errorf("NewObject: %v", err)
+					return
+				}
+				if *x != w<<32|i {
+					t.Errorf("slot shared between goroutines: %x", *x)
+					return
+				}
+				if err := p.Free(x); err != nil {
+					t.Errorf("Free: %v", err)
+					return
+				}
+			}
+		}(uint64(w))
+	}
+	wg.Wait()
+	if p.Len() != 0 {
+		t.Fatalf("Len %d after balanced churn", p.Len())
+	}
+	if p.arena.Offset() > slots*8 {
+		t.Fatalf("arena grew past capacity")
+	}
+}
+
+func TestAtomicPoolArena_Basic(t *testing.T) {
+	p, _ := NewAtomicPoolArena[int](16)
+	a, _ := p.NewObject(1)
+	b, _ := p.NewObject(2)
+	if _, err := p.NewObject(3); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+	if err := p.Free(new(int)); err != ErrInvalidPointer {
+		t.Fatalf("want ErrInvalidPointer, got %v", err)
+	}
+	p.Free(a)
+	c, _ := p.NewObject(4)
+	if c != a || *b != 2 || p.Len() != 2 || p.Cap() != 2 {
+		t.Fatalf("unexpected state: reuse=%v b=%d len=%d", c == a, *b, p.Len())
+	}
+	p.Reset()
+	if p.Len() != 0 || p.arena.Offset() != 0 {
+		t.Fatalf("Reset did not empty the pool")
+	}
+}
+
+func BenchmarkPoolArena_NewFree(b *testing.B) {
+	p, _ := NewPoolArena[point](1 << 16)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		x, _ := p.NewObject(point{i, i})
+		p.Free(x)
+	}
+}
+
+func BenchmarkAtomicPoolArena_NewFreeParallel(b *testing.B) {
+	p, _ := NewAtomicPoolArena[point](1 << 20)
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			if x, err := p.NewObject(point{1, 2}); err == nil {
+				p.Free(x)
+			}
+		}
+	})
+}
@@ -0,0 +1,111 @@
+package memoryArena
+
+import "unsafe"
+
+// RawArena is an untyped bump‑allocator for heterogeneous data.  Values of any
+// pointer‑free type are placed in it with New and MakeSlice, each honouring
+// its own type's alignment, so one arena can serve a request's structs, slices
+// and buffers alike.
+//
+// The backing memory is not scanned by the GC, so types containing pointers
+// are rejected with ErrInvalidType.  Like MemoryArena it is NOT goroutine‑safe.
+type RawArena struct {
+	buffer []byte         // backing storage (kept to satisfy GC & checkptr)
+	base   unsafe.Pointer // first maximally aligned byte inside buffer
+	size   int            // usable capacity in bytes
+	offset int            // current allocation offset (≤ size)
+}
+
+// maxAlign is the largest alignment any Go type requires.
+const maxAlign = int(unsafe.Alignof(complex128(0)))
+
+// NewRawArena allocates an untyped arena with `size` bytes of usable space.
+func NewRawArena(size int) (*RawArena, error) {
+	if size <= 0 {
+		return nil, ErrInvalidSize
+	}
+	buf, _, base, usable := newBacking[complex128](size)
+	return &RawArena{buffer: buf, base: base, size: usable}, nil
+}
+
+// Allocate reserves sz bytes aligned for any Go type.
+func (a *RawArena) Allocate(sz int) (unsafe.Pointer, error) {
+	if sz <= 0 {
+		return nil, ErrInvalidSize
+	}
+	return a.alloc(sz, maxAlign-1)
+}
+
+// alloc is the MemoryArena.Allocate bump with a per‑call alignment mask.
+func (a *RawArena) alloc(sz, alignMask int) (unsafe.Pointer, error) {
+	off := alignUp(a.offset, alignMask)
+	end := off + sz
+	if end > a.size || end < off {
+		return nil, ErrArenaFull
+	}
+	a.offset = end
+	return unsafe.Add(a.base, uintptr(off)), nil
+}
+
+// Reset zeroes the used memory and makes the whole arena available again.
+func (a *RawArena) Reset() {
+	if a.offset == 0 {
+		return
+	}
+	memclrNoHeapPointers(a.base, uintptr(a.offset))
+	a.offset = 0
+}
+
+func (a *RawArena) Offset() int {
+	return a.offset
+}
+
+func (a *RawArena) Base() unsafe.Pointer {
+	return a.base
+}
+
+// Capacity returns the usable size of the arena in bytes.
+func (a *RawArena) Capacity() int {
+	return a.size
+}
+
+// New allocates a U in a, copies v into it, and returns *U.
+func New[U any](a *RawArena, v U) (*U, error) {
+	if hasPointers[U]() {
+		return nil, ErrInvalidType
+	}
+	sz := int(unsafe.Sizeof(v))
+	if sz == 0 {
+		return new(U), nil
+	}
+	ptr, err := a.alloc(sz, int(unsafe.Alignof(v))-1)
+	if err != nil {
+		return nil, err
+	}
+	p := (*U)(ptr)
+	*p = v
+	return p, nil
+}
+
+// MakeSlice allocates a zeroed []U of the given length and capacity in a.
+func MakeSlice[U any](a *RawArena, length, capacity int) ([]U, error) {
+	if length < 0 || capacity < length {
+		return nil, ErrInvalidSize
+	}
+	if hasPointers[U]() {
+		return nil, ErrInvalidType
+	}
+	var dummy U
+	elemSize := int(unsafe.Sizeof(dummy))
+	if capacity == 0 || elemSize == 0 {
+		return make([]U, length, capacity), nil
+	}
+	if capacity > a.size/elemSize {
+		return nil, ErrArenaFull
+	}
+	ptr, err := a.alloc(capacity*elemSize, int(unsafe.Alignof(dummy))-1)
+	if err != nil {
+		return nil, err
+	}
+	return unsafe.Slice((*U)(ptr), capacity)[:length], nil
+}
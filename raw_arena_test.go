@@ -0,0 +1,96 @@
+package memoryArena
+
+import (
+	"testing"
+	"unsafe"
+)
+
+type header struct {
+	Kind  uint8
+	Flags uint16
+	Len   uint64
+}
+
+func TestNewRawArena_Errors(t *testing.T) {
+	if _, err := NewRawArena(0); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+}
+
+func TestRawArena_MixedTypes(t *testing.T) {
+	a, _ := NewRawArena(1024)
+
+	b, err := New(a, byte(7))
+	if err != nil {
+		t.Fatalf("New[byte]: %v", err)
+	}
+	h, err := New(a, header{Kind: 1, Flags: 2, Len: 3})
+	if err != nil {
+		t.Fatalf("New[header]: %v", err)
+	}
+	if uintptr(unsafe.Pointer(h))%unsafe.Alignof(*h) != 0 {
+		t.Fatalf("header misaligned: %p", h)
+	}
+	xs, err := MakeSlice[float64](a, 3, 8)
+	if err != nil {
+		t.Fatalf("MakeSlice: %v", err)
+	}
+	if len(xs) != 3 || cap(xs) != 8 {
+		t.Fatalf("len/cap = %d/%d, want 3/8", len(xs), cap(xs))
+	}
+	if uintptr(unsafe.Pointer(&xs[0]))%8 != 0 {
+		t.Fatalf("float64 slice misaligned")
+	}
+	xs = append(xs, 1.5)
+	if *b != 7 || h.Len != 3 || xs[3] != 1.5 || xs[0] != 0 {
+		t.Fatalf("values corrupted: %d %+v %v", *b, *h, xs)
+	}
+	end := uintptr(a.Base()) + uintptr(a.Offset())
+	if p := uintptr(unsafe.Pointer(&xs[0])); p < uintptr(a.Base()) || p >= end {
+		t.Fatalf("slice not placed in arena")
+	}
+}
+
+func TestRawArena_RejectsPointers(t *testing.T) {
+	a, _ := NewRawArena(64)
+	if _, err := New(a, "s"); err != ErrInvalidType {
+		t.Fatalf("New[string]: want ErrInvalidType, got %v", err)
+	}
+	if _, err := MakeSlice[*int](a, 1, 1); err != ErrInvalidType {
+		t.Fatalf("MakeSlice[*int]: want ErrInvalidType, got %v", err)
+	}
+}
+
+func TestRawArena_FullAndReset(t *testing.T) {
+	a, _ := NewRawArena(64)
+	if _, err := MakeSlice[int64](a, 0, 9); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+	if _, err := MakeSlice[int64](a, 2, 1); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+	xs, _ := MakeSlice[int64](a, 8, 8)
+	for i := range xs {
+		xs[i] = -1
+	}
+	if _, err := New(a, byte(1)); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+	a.Reset()
+	ys, _ := MakeSlice[int64](a, 8, 8)
+	for i, v := range ys {
+		if v != 0 {
+			t.Fatalf("ys[%d] = %d after Reset", i, v)
+		}
+	}
+}
+
+func BenchmarkRawArena_New(b *testing.B) {
+	a, _ := NewRawArena(1 << 20)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := New(a, header{Len: uint64(i)}); err != nil {
+			a.Reset()
+		}
+	}
+}
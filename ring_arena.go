@@ -0,0 +1,181 @@
+package memoryArena
+
+import "unsafe"
+
+// RingArena is a bump‑allocator over a circular buffer for streaming
+// workloads that allocate in order and release in order.  Positions are
+// logical byte counts that only grow: Head() is where the next allocation
+// starts, and Release(pos) reclaims everything allocated before pos.
+// Allocation fails with ErrArenaFull only when it would overrun the oldest
+// unreleased byte.
+//
+// Allocations are always contiguous: a block that does not fit before the
+// physical end of the buffer starts again at its beginning, and the skipped
+// tail is reclaimed together with the block.
+// Like MemoryArena it is NOT goroutine‑safe.
+type RingArena[T any] struct {
+	buffer    []byte         // backing storage (kept to satisfy GC & checkptr)
+	objects   []T            // typed backing storage when T holds pointers
+	base      unsafe.Pointer // first aligned byte inside the backing
+	size      int            // capacity in bytes, a multiple of T's alignment
+	head      uint64         // logical position of the next allocation
+	tail      uint64         // logical position of the oldest unreleased byte
+	alignMask int            // alignment-1 of T
+	elemSize  int            // sizeof(T)
+	pointers  bool           // backing is GC‑scanned; allocations are whole T slots
+}
+
+// NewRingArena allocates a ring with at least `size` bytes of capacity.
+func NewRingArena[T any](size int) (*RingArena[T], error) {
+	if size <= 0 {
+		return nil, ErrInvalidSize
+	}
+	var dummy T
+	alignMask := int(unsafe.Alignof(dummy)) - 1
+	buf, objs, base, usable := newBacking[T](size)
+	// Keep the physical wrap point aligned so logical alignment carries over.
+	usable &^= alignMask
+	if usable == 0 {
+		return nil, ErrInvalidSize
+	}
+	return &RingArena[T]{
+		buffer:    buf,
+		objects:   objs,
+		base:      base,
+		size:      usable,
+		alignMask: alignMask,
+		elemSize:  int(unsafe.Sizeof(dummy)),
+		pointers:  objs != nil,
+	}, nil
+}
+
+// Allocate reserves sz contiguous bytes, aligned for T, at the head of the ring.
+func (r *RingArena[T]) Allocate(sz int) (unsafe.Pointer, error) {
+	if sz <= 0 {
+		return nil, ErrInvalidSize
+	}
+	if r.pointers {
+		sz = roundSlots(sz, r.elemSize)
+	}
+	if sz > r.size {
+		return nil, ErrArenaFull
+	}
+	pos := uint64(alignUp(int(r.head%uint64(r.size)), r.alignMask))
+	start := r.head - r.head%uint64(r.size) + pos
+	if int(pos)+sz > r.size {
+		// Not enough room before the physical end: wrap to the start.
+		start += uint64(r.size) - pos
+		pos = 0
+	}
+	end := start + uint64(sz)
+	if end-r.tail > uint64(r.size) {
+		return nil, ErrArenaFull
+	}
+	r.head = end
+	return unsafe.Add(r.base, uintptr(pos)), nil
+}
+
+// NewObject allocates space for T, copies obj into it, and returns *T.
+func (r *RingArena[T]) NewObject(obj T) (*T, error) {
+	ptr, err := r.Allocate(r.elemSize)
+	if err != nil {
+		return nil, err
+	}
+	p := (*T)(ptr)
+	*p = obj
+	return p, nil
+}
+
+// AppendSlice appends elems to slice, moving it to a fresh block at the head
+// of the ring when it runs out of capacity.
+func (r *RingArena[T]) AppendSlice(slice []T, elems ...T) ([]T, error) {
+	if len(elems) == 0 {
+		return slice, nil
+	}
+	need := len(slice) + len(elems)
+	if need <= cap(slice) {
+		return append(slice, elems...), nil
+	}
+	maxCap := r.size / r.elemSize
+	if need > maxCap {
+		return slice, ErrArenaFull
+	}
+	newCap := nextPow2(need)
+	if newCap > maxCap {
+		newCap = maxCap
+	}
+	ptr, err := r.Allocate(newCap * r.elemSize)
+	if err != nil {
+		return slice, err
+	}
+	newArr := unsafe.Slice((*T)(ptr), newCap)
+	n := copy(newArr, slice)
+	copy(newArr[n:], elems)
+	return newArr[:need], nil
+}
+
+// Head returns the logical position just past the newest allocation.  Pass
+// it to Release once everything allocated so far may be reclaimed.
+func (r *RingArena[T]) Head() uint64 {
+	return r.head
+}
+
+// Tail returns the logical position of the oldest unreleased allocation.
+func (r *RingArena[T]) Tail() uint64 {
+	return r.tail
+}
+
+// Release zeroes and reclaims everything allocated before upTo, which must lie
+// between Tail() and Head() – and, when T holds pointers, on a T slot
+// boundary; anything else yields ErrInvalidPosition.
+func (r *RingArena[T]) Release(upTo uint64) error {
+	if upTo < r.tail || upTo > r.head {
+		return ErrInvalidPosition
+	}
+	if r.pointers && upTo%uint64(r.elemSize) != 0 {
+		return ErrInvalidPosition
+	}
+	r.clear(r.tail, upTo)
+	r.tail = upTo
+	return nil
+}
+
+// clear zeroes the logical range [from, to), which spans at most one wrap.
+func (r *RingArena[T]) clear(from, to uint64) {
+	if to <= from {
+		return
+	}
+	size := uint64(r.size)
+	if to-from >= size {
+		clearRange[T](r.base, 0, r.size, r.pointers)
+		return
+	}
+	start, end := int(from%size), int(to%size)
+	if start < end {
+		clearRange[T](r.base, start, end, r.pointers)
+		return
+	}
+	clearRange[T](r.base, start, r.size, r.pointers)
+	clearRange[T](r.base, 0, end, r.pointers)
+}
+
+// Reset releases everything and rewinds positions to zero; positions
+// obtained before the Reset must not be passed to Release afterwards.
+func (r *RingArena[T]) Reset() {
+	r.clear(r.tail, r.head)
+	r.head, r.tail = 0, 0
+}
+
+// Offset returns the number of unreleased bytes, including skipped padding.
+func (r *RingArena[T]) Offset() int {
+	return int(r.head - r.tail)
+}
+
+func (r *RingArena[T]) Base() unsafe.Pointer {
+	return r.base
+}
+
+// Capacity returns the size of the ring in bytes.
+func (r *RingArena[T]) Capacity() int {
+	return r.size
+}
@@ -0,0 +1,163 @@
+package memoryArena
+
+import (
+	"testing"
+	"unsafe"
+)
+
+var _ Arena[int] = (*RingArena[int])(nil)
+
+func TestNewRingArena_Errors(t *testing.T) {
+	if _, err := NewRingArena[int](0); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+	if _, err := NewRingArena[int](4); err != ErrInvalidSize {
+		t.Fatalf("capacity below one aligned unit: want ErrInvalidSize, got %v", err)
+	}
+}
+
+func TestRingArena_FIFOReclaim(t *testing.T) {
+	r, _ := NewRingArena[uint64](64) // 8 slots
+	var marks []uint64
+	for i := 0; i < 8; i++ {
+		if _, err := r.NewObject(uint64(i)); err != nil {
+			t.Fatalf("NewObject %d: %v", i, err)
+		}
+		marks = append(marks, r.Head())
+	}
+	if _, err := r.NewObject(8); err != ErrArenaFull {
+		t.Fatalf("head overran tail: %v", err)
+	}
+
+	// Release the oldest three; exactly three new objects fit.
+	if err := r.Release(marks[2]); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		p, err := r.NewObject(uint64(100 + i))
+		if err != nil {
+			t.Fatalf("NewObject after Release: %v", err)
+		}
+		if off := uintptr(unsafe.Pointer(p)) - uintptr(r.Base()); off != uintptr(i*8) {
+			t.Fatalf("wrapped object at offset %d, want %d", off, i*8)
+		}
+	}
+	if _, err := r.NewObject(0); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+	if r.Offset() != 64 {
+		t.Fatalf("offset %d, want 64", r.Offset())
+	}
+}
+
+func TestRingArena_WrapSkipsTail(t *testing.T) {
+	r, _ := NewRingArena[byte](100)
+	r.Allocate(60)
+	r.Release(r.Head())
+	// 40 bytes remain before the physical end; a 50 byte block wraps.
+	p, err := r.Allocate(50)
+	if err != nil {
+		t.Fatalf("Allocate: %v", err)
+	}
+	if p != r.Base() {
+		t.Fatalf("block did not wrap to the start")
+	}
+	if r.Offset() != 90 {
+		t.Fatalf("offset %d, want 90 (40 skipped + 50)", r.Offset())
+	}
+	if _, err := r.Allocate(11); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+	if _, err := r.Allocate(10); err != nil {
+		t.Fatalf("Allocate(10): %v", err)
+	}
+}
+
+func TestRingArena_ReleaseZeroes(t *testing.T) {
+	r, _ := NewRingArena[byte](32)
+	p, _ := r.Allocate(32)
+	b := unsafe.Slice((*byte)(p), 32)
+	for i := range b {
+		b[i] = 0xAA
+	}
+	r.Release(r.Tail() + 16)
+	for i, v := range b {
+		want := byte(0xAA)
+		if i < 16 {
+			want = 0
+		}
+		if v != want {
+			t.Fatalf("byte %d = %#x, want %#x", i, v, want)
+		}
+	}
+}
+
+func TestRingArena_InvalidRelease(t *testing.T) {
+	r, _ := NewRingArena[byte](32)
+	r.Allocate(8)
+	if err := r.Release(9); err != ErrInvalidPosition {
+		t.Fatalf("past head: want ErrInvalidPosition, got %v", err)
+	}
+	r.Release(8)
+	if err := r.Release(4); err != ErrInvalidPosition {
+		t.Fatalf("before tail: want ErrInvalidPosition, got %v", err)
+	}
+}
+
+func TestRingArena_ReleaseInsidePointerSlot(t *testing.T) {
+	r, _ := NewRingArena[*int](64)
+	p, _ := r.NewObject(new(int))
+	r.NewObject(new(int))
+	if err := r.Release(r.Tail() + 4); err != ErrInvalidPosition {
+		t.Fatalf("mid‑slot position: want ErrInvalidPosition, got %v", err)
+	}
+	if *p == nil {
+		t.Fatalf("rejected Release cleared the slot")
+	}
+	if err := r.Release(r.Tail() + 8); err != nil || *p != nil {
+		t.Fatalf("slot‑aligned Release: %v", err)
+	}
+}
+
+func TestRingArena_AppendSliceAndReset(t *testing.T) {
+	r, _ := NewRingArena[int](1024)
+	var s []int
+	var err error
+	for i := 0; i < 20; i++ {
+		if s, err = r.AppendSlice(s, i); err != nil {
+			t.Fatalf("AppendSlice: %v", err)
+		}
+	}
+	if len(s) != 20 || s[19] != 19 {
+		t.Fatalf("unexpected slice %v", s)
+	}
+	r.Reset()
+	if r.Offset() != 0 || r.Head() != 0 || s[0] != 0 {
+		t.Fatalf("Reset did not clear the ring")
+	}
+}
+
+func TestRingArena_KeepsPointersAlive(t *testing.T) {
+	r, _ := NewRingArena[person](4 * int(unsafe.Sizeof(person{})))
+	var marks []uint64
+	for i := 0; i < 4; i++ {
+		r.NewObject(newPerson(i))
+		marks = append(marks, r.Head())
+	}
+	r.Release(marks[1])
+	p, _ := r.NewObject(newPerson(9))
+	churnHeap()
+	checkPerson(t, p, 9)
+}
+
+func BenchmarkRingArena_Stream(b *testing.B) {
+	r, _ := NewRingArena[[64]byte](1 << 16)
+	var msg [64]byte
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := r.NewObject(msg); err != nil {
+			r.Release(r.Head())
+			r.NewObject(msg)
+		}
+	}
+}
@@ -0,0 +1,220 @@
+package memoryArena
+
+import (
+	"runtime"
+	"sync/atomic"
+	"unsafe"
+	_ "unsafe" // go:linkname
+)
+
+//go:linkname procPin runtime.procPin
+func procPin() int
+
+//go:linkname procUnpin runtime.procUnpin
+func procUnpin()
+
+// ShardedArena is a concurrent bump‑allocator that splits its capacity into
+// per‑P shards.  Each shard is an AtomicArena over its own slice of one shared
+// buffer, so goroutines running on different Ps CAS different offsets instead
+// of all fighting over one.  When its home shard is exhausted a goroutine
+// steals from the others, and a request larger than any shard's free space
+// spans several neighbouring shards, so the whole capacity stays usable.
+// Note: like AtomicArena, Reset must only be called when no allocations are in flight.
+type ShardedArena[T any] struct {
+	shards   []shard[T]
+	buffer   []byte         // backing storage shared by all shards
+	objects  []T            // typed backing storage when T holds pointers
+	base     unsafe.Pointer // first aligned byte inside the backing
+	elemSize int
+}
+
+// shard pads an AtomicArena so neighbouring offsets live on different cache lines.
+type shard[T any] struct {
+	AtomicArena[T]
+	_ [64]byte
+}
+
+// NewShardedArena allocates an arena with at least `size` bytes of usable
+// space split across n shards.  n <= 0 uses one shard per P (GOMAXPROCS).
+func NewShardedArena[T any](size, n int) (Arena[T], error) {
+	if size <= 0 {
+		return nil, ErrInvalidSize
+	}
+	if n <= 0 {
+		n = runtime.GOMAXPROCS(0)
+	}
+	var dummy T
+	alignMask := int(unsafe.Alignof(dummy)) - 1
+	elemSize := int(unsafe.Sizeof(dummy))
+	buf, objs, base, usable := newBacking[T](size)
+
+	// Shard boundaries must keep every shard's base aligned – and on whole
+	// T slots when the backing is GC‑scanned.
+	per := (usable / n) &^ alignMask
+	if objs != nil {
+		per = per / elemSize * elemSize
+	}
+	if per == 0 {
+		n, per = 1, usable
+	}
+
+	s := &ShardedArena[T]{
+		shards:   make([]shard[T], n),
+		buffer:   buf,
+		objects:  objs,
+		base:     base,
+		elemSize: elemSize,
+	}
+	for i := range s.shards {
+		a := &s.shards[i].AtomicArena
+		a.base = unsafe.Add(base, i*per)
+		a.size = uintptr(per)
+		a.reserved = uintptr(per)
+		a.alignMask = uintptr(alignMask)
+		a.elemSize = uintptr(elemSize)
+		a.pointers = objs != nil
+	}
+	// The last shard takes whatever the even split left over.
+	last := &s.shards[n-1].AtomicArena
+	last.size = uintptr(usable - (n-1)*per)
+	last.reserved = last.size
+	return s, nil
+}
+
+// home returns the index of the shard for the P the caller is running on.
+// The goroutine may migrate right after; the index is only an affinity hint.
+func (s *ShardedArena[T]) home() int {
+	id := procPin()
+	procUnpin()
+	return id % len(s.shards)
+}
+
+// Allocate reserves sz bytes, aligned for T, from the caller's home shard,
+// stealing from the other shards when it is full.
+func (s *ShardedArena[T]) Allocate(sz int) (unsafe.Pointer, error) {
+	if sz <= 0 {
+		return nil, ErrInvalidSize
+	}
+	i := s.home()
+	for range s.shards {
+		p, err := s.shards[i].allocate(sz)
+		if err != ErrArenaFull {
+			return p, err
+		}
+		if i++; i == len(s.shards) {
+			i = 0
+		}
+	}
+	return s.allocateSpan(sz)
+}
+
+// allocateSpan serves a request no single shard can hold from a run of
+// neighbouring shards: the free tail of shard i followed by shards that are
+// still empty.  The shards are claimed in order by CAS – all but the last are
+// marked full, the last only up to the end of the request – and released
+// again if a later claim loses a race.
+func (s *ShardedArena[T]) allocateSpan(sz int) (unsafe.Pointer, error) {
+	if s.objects != nil {
+		sz = roundSlots(sz, s.elemSize)
+	}
+	for i := range s.shards {
+		first := &s.shards[i].AtomicArena
+		head := atomic.LoadUint64(&first.offset)
+		off := (uintptr(head) + first.alignMask) &^ first.alignMask
+		if off >= first.size {
+			continue
+		}
+		// Find how many empty neighbours the request needs.
+		need := sz - int(first.size-off)
+		j := i + 1
+		for ; need > 0 && j < len(s.shards); j++ {
+			if atomic.LoadUint64(&s.shards[j].offset) != 0 {
+				break
+			}
+			need -= int(s.shards[j].size)
+		}
+		if need > 0 || j == i+1 {
+			continue
+		}
+		if !atomic.CompareAndSwapUint64(&first.offset, head, uint64(first.size)) {
+			continue
+		}
+		claimed := i + 1
+		for ; claimed < j; claimed++ {
+			end := s.shards[claimed].size
+			if claimed == j-1 {
+				end -= uintptr(-need) // the last shard keeps its unused tail
+			}
+			if !atomic.CompareAndSwapUint64(&s.shards[claimed].offset, 0, uint64(end)) {
+				break
+			}
+		}
+		if claimed < j {
+			// Lost a race: the shards claimed so far are full, so nobody
+			// else touched them and they can simply be handed back.
+			for k := i + 1; k < claimed; k++ {
+				atomic.StoreUint64(&s.shards[k].offset, 0)
+			}
+			atomic.StoreUint64(&first.offset, head)
+			continue
+		}
+		if off != uintptr(head) {
+			atomic.AddUint64(&first.stats.padding, uint64(off-uintptr(head)))
+		}
+		return unsafe.Add(first.base, off), nil
+	}
+	return nil, ErrArenaFull
+}
+
+// NewObject allocates space for T, copies obj into it, and returns *T.
+func (s *ShardedArena[T]) NewObject(obj T) (*T, error) {
+	ptr, err := s.Allocate(s.elemSize)
+	if err != nil {
+		return nil, err
+	}
+	r := (*T)(ptr)
+	*r = obj
+	return r, nil
+}
+
+// AppendSlice appends elems to slice, moving it to a fresh block when it
+// runs out of capacity.
+func (s *ShardedArena[T]) AppendSlice(slice []T, elems ...T) ([]T, error) {
+	if len(elems) == 0 {
+		return slice, nil
+	}
+	need := len(slice) + len(elems)
+	if need <= cap(slice) {
+		return append(slice, elems...), nil
+	}
+	newCap := nextPow2(need)
+	ptr, err := s.Allocate(newCap * s.elemSize)
+	if err != nil {
+		return nil, err
+	}
+	newArr := unsafe.Slice((*T)(ptr), newCap)
+	n := copy(newArr, slice)
+	copy(newArr[n:], elems)
+	return newArr[:need], nil
+}
+
+// Reset zeros every shard and makes the whole capacity available again.
+// Not safe to call concurrently with Allocate.
+func (s *ShardedArena[T]) Reset() {
+	for i := range s.shards {
+		s.shards[i].Reset()
+	}
+}
+
+// Offset returns the number of bytes in use summed over all shards.
+func (s *ShardedArena[T]) Offset() int {
+	used := 0
+	for i := range s.shards {
+		used += s.shards[i].Offset()
+	}
+	return used
+}
+
+func (s *ShardedArena[T]) Base() unsafe.Pointer {
+	return s.base
+}
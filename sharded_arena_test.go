@@ -0,0 +1,228 @@
+package memoryArena
+
+import (
+	"fmt"
+	"runtime"
+	"sync"
+	"testing"
+	"unsafe"
+)
+
+func TestNewShardedArena_Errors(t *testing.T) {
+	if _, err := NewShardedArena[int](0, 4); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+	// Too small to split: falls back to a single shard.
+	a, err := NewShardedArena[int](8, 16)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := len(a.(*ShardedArena[int]).shards); n != 1 {
+		t.Fatalf("want 1 shard, got %d", n)
+	}
+}
+
+func TestShardedArena_Basic(t *testing.T) {
+	a, _ := NewShardedArena[int](1024, 4)
+	p, err := a.NewObject(42)
+	if err != nil || *p != 42 {
+		t.Fatalf("NewObject: %v", err)
+	}
+	s, err := a.AppendSlice([]int{1, 2}, 3, 4, 5)
+	if err != nil || len(s) != 5 || s[4] != 5 {
+		t.Fatalf("AppendSlice: %v %v", s, err)
+	}
+	if a.Offset() != 8+8*8 {
+		t.Fatalf("offset %d, want %d", a.Offset(), 8+8*8)
+	}
+	a.Reset()
+	if a.Offset() != 0 {
+		t.Fatalf("offset %d after Reset", a.Offset())
+	}
+}
+
+// Every byte of every shard is reachable through stealing.
+func TestShardedArena_StealsUntilFull(t *testing.T) {
+	const shards, perShard = 4, 256
+	a, _ := NewShardedArena[uint64](shards*perShard*8, shards)
+
+	var (
+		mu   sync.Mutex
+		seen = map[*uint64]bool{}
+		wg   sync.WaitGroup
+	)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; ; i++ {
+				p, err := a.NewObject(uint64(g)<<32 | uint64(i))
+				if err == ErrArenaFull {
+					return
+				}
+				if err != nil {
+					t.Errorf("NewObject: %v", err)
+					return
+				}
+				mu.Lock()
+				if seen[p] {
+					t.Errorf("pointer %p handed out twice", p)
+				}
+				seen[p] = true
+				mu.Unlock()
+			}
+		}(g)
+	}
+	wg.Wait()
+	if len(seen) != shards*perShard {
+		t.Fatalf("allocated %d objects, want %d", len(seen), shards*perShard)
+	}
+	start := uintptr(a.Base())
+	for p := range seen {
+		if off := uintptr(unsafe.Pointer(p)) - start; off >= shards*perShard*8 || off%8 != 0 {
+			t.Fatalf("pointer outside arena or misaligned: offset %d", off)
+		}
+	}
+}
+
+// Requests larger than one shard span neighbouring shards.
+func TestShardedArena_LargerThanShard(t *testing.T) {
+	a, _ := NewShardedArena[int](64<<10, 16) // 4KiB shards
+	p, err := a.Allocate(8192)
+	if err != nil {
+		t.Fatalf("Allocate(8192) on an empty arena: %v", err)
+	}
+	mem := unsafe.Slice((*byte)(p), 8192)
+	for i := range mem {
+		mem[i] = 0xAB
+	}
+	// The last spanned shard keeps serving small objects after the request.
+	if a.Offset() != 8192 {
+		t.Fatalf("Offset %d, want 8192", a.Offset())
+	}
+
+	var s []int
+	for i := 0; i < 2048; i++ {
+		if s, err = a.AppendSlice(s, i); err != nil {
+			t.Fatalf("AppendSlice at len %d (offset %d): %v", len(s), a.Offset(), err)
+		}
+	}
+	for i, v := range s {
+		if v != i {
+			t.Fatalf("s[%d] = %d", i, v)
+		}
+	}
+	for i, b := range mem {
+		if b != 0xAB {
+			t.Fatalf("spanned block overwritten at %d", i)
+		}
+	}
+
+	a.Reset()
+	if _, err := a.Allocate(64 << 10); err != nil {
+		t.Fatalf("whole capacity in one request: %v", err)
+	}
+	if _, err := a.NewObject(1); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+}
+
+func TestShardedArena_SpanConcurrent(t *testing.T) {
+	const shards = 8
+	a, _ := NewShardedArena[uint64](shards*1024, shards)
+	var (
+		mu    sync.Mutex
+		owner = map[uintptr]int{}
+		wg    sync.WaitGroup
+	)
+	for g := 0; g < 8; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; ; i++ {
+				sz := 8
+				if i%4 == 0 {
+					sz = 1536 // more than a 1KiB shard
+				}
+				p, err := a.Allocate(sz)
+				if err == ErrArenaFull {
+					if sz == 8 {
+						return
+					}
+					continue
+				}
+				mu.Lock()
+				for off := uintptr(0); off < uintptr(sz); off += 8 {
+					if prev, ok := owner[uintptr(p)+off]; ok {
+						t.Errorf("word %#x handed to %d and %d", uintptr(p)+off, prev, g)
+					}
+					owner[uintptr(p)+off] = g
+				}
+				mu.Unlock()
+			}
+		}(g)
+	}
+	wg.Wait()
+}
+
+func TestShardedArena_KeepsPointersAlive(t *testing.T) {
+	testArenaKeepsPointersAlive(t, func(size int) (Arena[person], error) {
+		return NewShardedArena[person](size, 4)
+	})
+}
+
+// -----------------------------------------------------------------------------
+// Benchmarks – compare against BenchmarkAtomicArena_NewObject and
+// BenchmarkConcurrentArenaParallel.
+// -----------------------------------------------------------------------------
+
+func BenchmarkShardedArena_NewObject(b *testing.B) {
+	sizes := []int{100, 1_000, 10_000, 100_000, 1000000, 10000000, 100000000}
+	for _, sz := range sizes {
+		b.Run("Size_"+fmt.Sprint(sz), func(b *testing.B) {
+			arena, _ := NewShardedArena[int](sz, 0)
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				_, _ = arena.NewObject(i)
+			}
+		})
+	}
+}
+
+// BenchmarkShardedArenaParallel has GOMAXPROCS goroutines fill the arena with
+// 8‑byte objects, then resets it; one op is one object.
+func BenchmarkShardedArenaParallel(b *testing.B) {
+	arenas := []struct {
+		name string
+		new  func(int) (Arena[int], error)
+	}{
+		{"Sharded", func(n int) (Arena[int], error) { return NewShardedArena[int](n, 0) }},
+		{"Atomic", NewAtomicArena[int]},
+		{"Concurrent", NewConcurrentArena[int]},
+	}
+	for _, tc := range arenas {
+		b.Run(tc.name, func(b *testing.B) {
+			workers := runtime.GOMAXPROCS(0)
+			const perWorker = 4096
+			arena, _ := tc.new(workers * perWorker * 8)
+			b.ResetTimer()
+			for done := 0; done < b.N; done += workers * perWorker {
+				var wg sync.WaitGroup
+				wg.Add(workers)
+				for w := 0; w < workers; w++ {
+					go func() {
+						defer wg.Done()
+						for i := 0; i < perWorker; i++ {
+							if _, err := arena.NewObject(i); err != nil {
+								b.Error(err)
+								return
+							}
+						}
+					}()
+				}
+				wg.Wait()
+				arena.Reset()
+			}
+		})
+	}
+}
@@ -0,0 +1,247 @@
+package memoryArena
+
+import (
+	"encoding/binary"
+	"math/bits"
+	"unsafe"
+)
+
+const (
+	minSlabClass = 8        // smallest size class in bytes
+	maxSlabClass = 32 << 10 // largest size class in bytes
+	slabSize     = 64 << 10 // bytes carved from the arena per slab
+	numSlabClass = 13       // 8, 16, 32 … 32KiB
+)
+
+// SlabAllocator serves variable‑size Allocate requests from a RawArena and,
+// unlike the bump arenas, lets each block be returned with Free.  Requests are
+// rounded up to a power‑of‑two size class between 8 bytes and 32KiB; every
+// class carves fixed 64KiB slabs out of the arena and keeps freed blocks on a
+// per‑slab free list.  A slab whose blocks are all freed goes back to a shared
+// pool and may be reused by any class, so mixed‑lifetime buffers of different
+// sizes share one arena without fragmenting it for good.
+//
+// Blocks are zeroed and aligned for any Go type.  As with RawArena the memory
+// is not scanned by the GC, so it must not hold the only reference to heap
+// objects.  SlabAllocator is NOT goroutine‑safe.
+type SlabAllocator struct {
+	arena   *RawArena
+	slabs   []slab  // every slab carved so far, in address order
+	empty   []int32 // indices of carved slabs with no live blocks
+	classes [numSlabClass]slabClass
+}
+
+// slab is the bookkeeping for one slabSize region of the arena.
+type slab struct {
+	class   int8   // size class, -1 while the slab is empty
+	partial int32  // position in its class's partial list, -1 when full
+	used    int32  // live blocks
+	bump    int32  // bytes handed out by bumping so far
+	free    uint32 // offset+1 of the first freed block; 0 when none
+}
+
+// slabClass tracks the slabs of one size class that still have room.
+type slabClass struct {
+	partial []int32 // slabs with at least one free or unbumped block
+	slabs   int     // slabs currently assigned to the class
+	live    int     // live blocks across those slabs
+}
+
+// SlabClassStats reports the occupancy of one size class.
+type SlabClassStats struct {
+	Size     int // block size in bytes
+	Slabs    int // slabs assigned to the class
+	Objects  int // live blocks
+	Capacity int // blocks the assigned slabs can hold
+}
+
+// NewSlabAllocator allocates a slab allocator over at least `size` bytes,
+// rounded up to whole 64KiB slabs.
+func NewSlabAllocator(size int) (*SlabAllocator, error) {
+	if size <= 0 {
+		return nil, ErrInvalidSize
+	}
+	a, err := NewRawArena(alignUp(size, slabSize-1))
+	if err != nil {
+		return nil, err
+	}
+	return &SlabAllocator{arena: a}, nil
+}
+
+// slabClassOf returns the size class that serves sz bytes.
+func slabClassOf(sz int) int {
+	if sz <= minSlabClass {
+		return 0
+	}
+	return bits.Len(uint(sz-1)) - 3
+}
+
+func slabClassSize(c int) int {
+	return minSlabClass << c
+}
+
+// Allocate returns a zeroed block of at least sz bytes.  Requests above 32KiB
+// are rejected with ErrInvalidSize.
+func (s *SlabAllocator) Allocate(sz int) (unsafe.Pointer, error) {
+	if sz <= 0 || sz > maxSlabClass {
+		return nil, ErrInvalidSize
+	}
+	c := slabClassOf(sz)
+	cls := &s.classes[c]
+	if len(cls.partial) == 0 {
+		if err := s.grow(c); err != nil {
+			return nil, err
+		}
+	}
+	i := cls.partial[len(cls.partial)-1]
+	sl := &s.slabs[i]
+	base := int(i) * slabSize
+	var off int
+	if sl.free != 0 {
+		off = int(sl.free - 1)
+		link := s.block(base+off, 4)
+		sl.free = binary.NativeEndian.Uint32(link)
+		binary.NativeEndian.PutUint32(link, 0)
+	} else {
+		off = int(sl.bump)
+		sl.bump += int32(slabClassSize(c))
+	}
+	sl.used++
+	cls.live++
+	if sl.free == 0 && int(sl.bump) == slabSize {
+		s.unlinkPartial(i)
+	}
+	return unsafe.Add(s.arena.base, base+off), nil
+}
+
+// grow assigns a slab to class c, reusing an empty one before carving more
+// from the arena.
+func (s *SlabAllocator) grow(c int) error {
+	var i int32
+	if n := len(s.empty); n > 0 {
+		i = s.empty[n-1]
+		s.empty = s.empty[:n-1]
+	} else {
+		if _, err := s.arena.alloc(slabSize, maxAlign-1); err != nil {
+			return err
+		}
+		i = int32(len(s.slabs))
+		s.slabs = append(s.slabs, slab{})
+	}
+	s.slabs[i] = slab{class: int8(c), partial: -1}
+	s.classes[c].slabs++
+	s.linkPartial(i)
+	return nil
+}
+
+func (s *SlabAllocator) linkPartial(i int32) {
+	cls := &s.classes[s.slabs[i].class]
+	s.slabs[i].partial = int32(len(cls.partial))
+	cls.partial = append(cls.partial, i)
+}
+
+// unlinkPartial removes slab i from its class's partial list in O(1).
+func (s *SlabAllocator) unlinkPartial(i int32) {
+	cls := &s.classes[s.slabs[i].class]
+	p := s.slabs[i].partial
+	last := cls.partial[len(cls.partial)-1]
+	cls.partial[p] = last
+	s.slabs[last].partial = p
+	cls.partial = cls.partial[:len(cls.partial)-1]
+	s.slabs[i].partial = -1
+}
+
+func (s *SlabAllocator) block(off, n int) []byte {
+	return unsafe.Slice((*byte)(unsafe.Add(s.arena.base, off)), n)
+}
+
+// Free zeroes the block at ptr and makes it available again.  sz must be the
+// size passed to Allocate (any size in the same class is accepted).  Pointers
+// that cannot belong to a live block of that class return ErrInvalidPointer;
+// freeing a block twice is not detected.
+func (s *SlabAllocator) Free(ptr unsafe.Pointer, sz int) error {
+	if sz <= 0 || sz > maxSlabClass {
+		return ErrInvalidSize
+	}
+	c := slabClassOf(sz)
+	csz := slabClassSize(c)
+	off := int(uintptr(ptr) - uintptr(s.arena.base))
+	if ptr == nil || off < 0 || off >= len(s.slabs)*slabSize {
+		return ErrInvalidPointer
+	}
+	i := int32(off / slabSize)
+	sl := &s.slabs[i]
+	in := off % slabSize
+	if int(sl.class) != c || in%csz != 0 || in >= int(sl.bump) || sl.used == 0 {
+		return ErrInvalidPointer
+	}
+
+	clear(s.block(off, csz))
+	binary.NativeEndian.PutUint32(s.block(off, 4), sl.free)
+	sl.free = uint32(in + 1)
+	sl.used--
+	s.classes[c].live--
+
+	switch {
+	case sl.used == 0:
+		// Every block is zeroed apart from its free‑list link; clear those
+		// and hand the whole slab back for any class to reuse.
+		if sl.partial >= 0 {
+			s.unlinkPartial(i)
+		}
+		s.clearLinks(i)
+		s.classes[c].slabs--
+		*sl = slab{class: -1, partial: -1}
+		s.empty = append(s.empty, i)
+	case sl.partial < 0:
+		s.linkPartial(i)
+	}
+	return nil
+}
+
+// clearLinks zeroes the free‑list links threaded through slab i.
+func (s *SlabAllocator) clearLinks(i int32) {
+	base := int(i) * slabSize
+	for f := s.slabs[i].free; f != 0; {
+		link := s.block(base+int(f-1), 4)
+		f = binary.NativeEndian.Uint32(link)
+		binary.NativeEndian.PutUint32(link, 0)
+	}
+}
+
+// Reset releases every block and slab at once.
+func (s *SlabAllocator) Reset() {
+	s.arena.Reset()
+	s.slabs = s.slabs[:0]
+	s.empty = s.empty[:0]
+	for c := range s.classes {
+		s.classes[c] = slabClass{partial: s.classes[c].partial[:0]}
+	}
+}
+
+// Occupancy returns per‑class usage, one entry per size class from 8 bytes
+// up to 32KiB.
+func (s *SlabAllocator) Occupancy() []SlabClassStats {
+	out := make([]SlabClassStats, numSlabClass)
+	for c := range s.classes {
+		csz := slabClassSize(c)
+		out[c] = SlabClassStats{
+			Size:     csz,
+			Slabs:    s.classes[c].slabs,
+			Objects:  s.classes[c].live,
+			Capacity: s.classes[c].slabs * (slabSize / csz),
+		}
+	}
+	return out
+}
+
+// Slabs returns the number of slabs carved from the arena and how many of
+// them are currently empty.
+func (s *SlabAllocator) Slabs() (carved, empty int) {
+	return len(s.slabs), len(s.empty)
+}
+
+// Capacity returns the size of the underlying arena in bytes.
+func (s *SlabAllocator) Capacity() int {
+	return s.arena.Capacity()
+}
@@ -0,0 +1,162 @@
+package memoryArena
+
+import (
+	"testing"
+	"unsafe"
+)
+
+func TestNewSlabAllocator_Errors(t *testing.T) {
+	if _, err := NewSlabAllocator(0); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+	s, _ := NewSlabAllocator(1)
+	if s.Capacity() != slabSize {
+		t.Fatalf("capacity %d, want one slab", s.Capacity())
+	}
+	if _, err := s.Allocate(maxSlabClass + 1); err != ErrInvalidSize {
+		t.Fatalf("oversized request: want ErrInvalidSize, got %v", err)
+	}
+}
+
+func TestSlabAllocator_SizeClasses(t *testing.T) {
+	s, _ := NewSlabAllocator(1 << 20)
+	for _, tc := range []struct{ sz, class int }{
+		{1, 8}, {8, 8}, {9, 16}, {100, 128}, {4096, 4096}, {4097, 8192}, {32 << 10, 32 << 10},
+	} {
+		if got := slabClassSize(slabClassOf(tc.sz)); got != tc.class {
+			t.Errorf("class for %d = %d, want %d", tc.sz, got, tc.class)
+		}
+		p, err := s.Allocate(tc.sz)
+		if err != nil {
+			t.Fatalf("Allocate(%d): %v", tc.sz, err)
+		}
+		if uintptr(p)%uintptr(maxAlign) != 0 {
+			t.Fatalf("Allocate(%d) misaligned: %p", tc.sz, p)
+		}
+	}
+	occ := s.Occupancy()
+	if len(occ) != numSlabClass || occ[0].Size != 8 || occ[numSlabClass-1].Size != 32<<10 {
+		t.Fatalf("unexpected classes: %+v", occ)
+	}
+	if occ[0].Objects != 2 || occ[0].Slabs != 1 || occ[0].Capacity != slabSize/8 {
+		t.Fatalf("8‑byte class: %+v", occ[0])
+	}
+}
+
+func TestSlabAllocator_FreeReuses(t *testing.T) {
+	s, _ := NewSlabAllocator(slabSize)
+	const sz = 1024
+	var blocks []unsafe.Pointer
+	for {
+		p, err := s.Allocate(sz)
+		if err == ErrArenaFull {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		unsafe.Slice((*byte)(p), sz)[0] = 0xAB
+		blocks = append(blocks, p)
+	}
+	if len(blocks) != slabSize/sz {
+		t.Fatalf("allocated %d blocks, want %d", len(blocks), slabSize/sz)
+	}
+
+	if err := s.Free(blocks[5], sz); err != nil {
+		t.Fatalf("Free: %v", err)
+	}
+	p, err := s.Allocate(sz - 10)
+	if err != nil || p != blocks[5] {
+		t.Fatalf("freed block not reused: %v", err)
+	}
+	for i, b := range unsafe.Slice((*byte)(p), sz) {
+		if b != 0 {
+			t.Fatalf("reused block not zeroed at %d", i)
+		}
+	}
+}
+
+func TestSlabAllocator_EmptySlabChangesClass(t *testing.T) {
+	s, _ := NewSlabAllocator(slabSize)
+	var small []unsafe.Pointer
+	for i := 0; i < 100; i++ {
+		p, _ := s.Allocate(64)
+		small = append(small, p)
+	}
+	if _, err := s.Allocate(4096); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull while the only slab serves 64B, got %v", err)
+	}
+	for _, p := range small {
+		if err := s.Free(p, 64); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if carved, empty := s.Slabs(); carved != 1 || empty != 1 {
+		t.Fatalf("slabs = %d/%d, want 1 carved, 1 empty", carved, empty)
+	}
+	p, err := s.Allocate(4096)
+	if err != nil {
+		t.Fatalf("empty slab not reused by another class: %v", err)
+	}
+	for i, b := range unsafe.Slice((*byte)(p), 4096) {
+		if b != 0 {
+			t.Fatalf("recycled slab not zeroed at %d", i)
+		}
+	}
+	if occ := s.Occupancy(); occ[slabClassOf(64)].Slabs != 0 || occ[slabClassOf(4096)].Objects != 1 {
+		t.Fatalf("occupancy not moved: %+v", occ)
+	}
+}
+
+func TestSlabAllocator_InvalidFree(t *testing.T) {
+	s, _ := NewSlabAllocator(1 << 20)
+	p, _ := s.Allocate(64)
+	var x [64]byte
+	cases := map[string]struct {
+		ptr unsafe.Pointer
+		sz  int
+	}{
+		"nil":      {nil, 64},
+		"foreign":  {unsafe.Pointer(&x), 64},
+		"interior": {unsafe.Add(p, 8), 64},
+		"class":    {p, 256},
+		"unissued": {unsafe.Add(p, 64), 64},
+	}
+	for name, tc := range cases {
+		if err := s.Free(tc.ptr, tc.sz); err != ErrInvalidPointer {
+			t.Errorf("%s: want ErrInvalidPointer, got %v", name, err)
+		}
+	}
+	if err := s.Free(p, 0); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+}
+
+func TestSlabAllocator_Reset(t *testing.T) {
+	s, _ := NewSlabAllocator(2 * slabSize)
+	s.Allocate(16)
+	s.Allocate(16 << 10)
+	s.Reset()
+	if carved, _ := s.Slabs(); carved != 0 {
+		t.Fatalf("%d slabs after Reset", carved)
+	}
+	for _, c := range s.Occupancy() {
+		if c.Slabs != 0 || c.Objects != 0 {
+			t.Fatalf("class %d not cleared: %+v", c.Size, c)
+		}
+	}
+	if _, err := s.Allocate(32 << 10); err != nil {
+		t.Fatalf("Allocate after Reset: %v", err)
+	}
+}
+
+func BenchmarkSlabAllocator_AllocFree(b *testing.B) {
+	s, _ := NewSlabAllocator(1 << 20)
+	sizes := []int{24, 100, 512, 3000}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		sz := sizes[i&3]
+		p, _ := s.Allocate(sz)
+		s.Free(p, sz)
+	}
+}
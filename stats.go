@@ -0,0 +1,44 @@
+package memoryArena
+
+import "sync/atomic"
+
+// Stats is a snapshot of an arena's allocation counters.
+type Stats struct {
+	Capacity int    // usable bytes
+	InUse    int    // bytes between Base() and the current offset
+	Peak     int    // highest offset since creation or the last Reset
+	Allocs   uint64 // Allocate calls
+	Objects  uint64 // NewObject calls
+	Appends  uint64 // AppendSlice calls
+	Padding  uint64 // bytes skipped to satisfy alignment
+	Failures uint64 // allocations that failed with ErrArenaFull
+	Resets   uint64 // Reset calls
+}
+
+// StatsReporter is implemented by arenas that keep allocation statistics.
+// MemoryArena, ConcurrentArena and AtomicArena all do.
+type StatsReporter interface {
+	Stats() Stats
+}
+
+// counters backs Stats.  MemoryArena updates it in place, AtomicArena only
+// through sync/atomic.  peak is refreshed lazily – whenever the offset is about
+// to move backwards – so the allocation fast path never has to touch it.
+type counters struct {
+	allocs   uint64
+	objects  uint64
+	appends  uint64
+	padding  uint64
+	failures uint64
+	peak     uint64
+}
+
+// raisePeak atomically lifts c.peak to at least off.
+func (c *counters) raisePeak(off uint64) {
+	for {
+		p := atomic.LoadUint64(&c.peak)
+		if off <= p || atomic.CompareAndSwapUint64(&c.peak, p, off) {
+			return
+		}
+	}
+}
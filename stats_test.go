@@ -0,0 +1,68 @@
+package memoryArena
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestStats_Counters(t *testing.T) {
+	arenas := map[string]func(int) (Arena[uint64], error){
+		"MemoryArena":     NewMemoryArena[uint64],
+		"AtomicArena":     NewAtomicArena[uint64],
+		"ConcurrentArena": NewConcurrentArena[uint64],
+	}
+	for name, newArena := range arenas {
+		t.Run(name, func(t *testing.T) {
+			a, _ := newArena(128)
+			a.Allocate(1)            // 1 byte
+			a.NewObject(7)           // 7 bytes of padding, then 8
+			a.NewObject(8)           // offset 24
+			a.AppendSlice(nil, 1, 2) // 8 slots = 64 bytes → offset 88
+			a.Allocate(64)           // does not fit
+			a.AppendSlice(nil, make([]uint64, 20)...)
+
+			s := a.(StatsReporter).Stats()
+			want := Stats{
+				Capacity: 128, InUse: 88, Peak: 88,
+				Allocs: 2, Objects: 2, Appends: 2,
+				Padding: 7, Failures: 2, Resets: 0,
+			}
+			if s != want {
+				t.Fatalf("Stats = %+v\nwant    %+v", s, want)
+			}
+
+			m := a.(Rewinder).Mark()
+			a.NewObject(1)
+			a.(Rewinder).Rewind(m)
+			if s := a.(StatsReporter).Stats(); s.Peak != 96 || s.InUse != 88 {
+				t.Fatalf("after Rewind peak=%d inUse=%d, want 96 and 88", s.Peak, s.InUse)
+			}
+
+			a.Reset()
+			s = a.(StatsReporter).Stats()
+			if s.InUse != 0 || s.Peak != 0 || s.Resets != 1 || s.Allocs != 2 {
+				t.Fatalf("after Reset: %+v", s)
+			}
+		})
+	}
+}
+
+func TestStats_AtomicArenaConcurrent(t *testing.T) {
+	a, _ := NewAtomicArena[uint64](1 << 16)
+	const workers, per = 8, 500
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < per; i++ {
+				a.NewObject(uint64(i))
+			}
+		}()
+	}
+	wg.Wait()
+	s := a.(StatsReporter).Stats()
+	if s.Objects != workers*per || s.InUse != workers*per*8 || s.Peak != s.InUse {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+}
@@ -0,0 +1,129 @@
+package memoryArena
+
+import "unsafe"
+
+// StringArena copies string data into a RawArena so that strings built per
+// request – the Name of a Person created with NewObject, say – no longer cost
+// a heap allocation each.  The strings it returns point into arena memory:
+// they stay valid until Reset, after which their bytes are zeroed and reused.
+//
+// With interning enabled, equal strings created in the same arena share one
+// copy until Reset.  Like RawArena it is NOT goroutine‑safe.
+type StringArena struct {
+	arena  *RawArena
+	intern map[string]string // arena copies by content; nil without interning
+}
+
+// NewStringArena allocates a string arena with `size` bytes of character
+// storage.  intern enables deduplication of equal strings.
+func NewStringArena(size int, intern bool) (*StringArena, error) {
+	a, err := NewRawArena(size)
+	if err != nil {
+		return nil, err
+	}
+	s := &StringArena{arena: a}
+	if intern {
+		s.intern = make(map[string]string)
+	}
+	return s, nil
+}
+
+// NewString returns a copy of str stored in the arena.
+func (s *StringArena) NewString(str string) (string, error) {
+	if len(str) == 0 {
+		return "", nil
+	}
+	if v, ok := s.intern[str]; ok {
+		return v, nil
+	}
+	buf, err := s.alloc(len(str))
+	if err != nil {
+		return "", err
+	}
+	copy(buf, str)
+	return s.store(buf), nil
+}
+
+// StringFromBytes returns a string with the contents of b stored in the arena.
+// b may be modified afterwards without affecting the result.
+func (s *StringArena) StringFromBytes(b []byte) (string, error) {
+	if len(b) == 0 {
+		return "", nil
+	}
+	if v, ok := s.intern[string(b)]; ok { // no allocation for the lookup
+		return v, nil
+	}
+	buf, err := s.alloc(len(b))
+	if err != nil {
+		return "", err
+	}
+	copy(buf, b)
+	return s.store(buf), nil
+}
+
+// Concat returns the concatenation of parts stored in the arena, without an
+// intermediate heap string.
+func (s *StringArena) Concat(parts ...string) (string, error) {
+	n := 0
+	for _, p := range parts {
+		n += len(p)
+	}
+	if n == 0 {
+		return "", nil
+	}
+	mark := s.arena.offset
+	buf, err := s.alloc(n)
+	if err != nil {
+		return "", err
+	}
+	off := 0
+	for _, p := range parts {
+		off += copy(buf[off:], p)
+	}
+	if v, ok := s.intern[unsafe.String(&buf[0], n)]; ok {
+		// Already interned: give the fresh copy back.
+		clear(buf)
+		s.arena.offset = mark
+		return v, nil
+	}
+	return s.store(buf), nil
+}
+
+func (s *StringArena) alloc(n int) ([]byte, error) {
+	ptr, err := s.arena.alloc(n, 0)
+	if err != nil {
+		return nil, err
+	}
+	return unsafe.Slice((*byte)(ptr), n), nil
+}
+
+// store turns an arena copy into a string, recording it when interning.
+func (s *StringArena) store(buf []byte) string {
+	str := unsafe.String(&buf[0], len(buf))
+	if s.intern != nil {
+		s.intern[str] = str
+	}
+	return str
+}
+
+// Reset invalidates every string handed out, empties the interning table and
+// makes the whole arena available again.
+func (s *StringArena) Reset() {
+	clear(s.intern)
+	s.arena.Reset()
+}
+
+// Len returns the number of interned strings; it is 0 without interning.
+func (s *StringArena) Len() int {
+	return len(s.intern)
+}
+
+// Offset returns the number of bytes of character data stored.
+func (s *StringArena) Offset() int {
+	return s.arena.Offset()
+}
+
+// Capacity returns the size of the character storage in bytes.
+func (s *StringArena) Capacity() int {
+	return s.arena.Capacity()
+}
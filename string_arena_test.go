@@ -0,0 +1,108 @@
+package memoryArena
+
+import (
+	"strings"
+	"testing"
+	"unsafe"
+)
+
+// inArena reports whether str's bytes live in s's arena.
+func inArena(s *StringArena, str string) bool {
+	p := uintptr(unsafe.Pointer(unsafe.StringData(str)))
+	base := uintptr(s.arena.Base())
+	return p >= base && p < base+uintptr(s.Capacity())
+}
+
+func TestStringArena_Copies(t *testing.T) {
+	s, err := NewStringArena(256, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a, _ := s.NewString("hello")
+	b := []byte("world")
+	c, _ := s.StringFromBytes(b)
+	b[0] = 'W'
+	d, _ := s.Concat(a, ", ", c, "!")
+	if a != "hello" || c != "world" || d != "hello, world!" {
+		t.Fatalf("got %q %q %q", a, c, d)
+	}
+	for _, str := range []string{a, c, d} {
+		if !inArena(s, str) {
+			t.Fatalf("%q not stored in the arena", str)
+		}
+	}
+	if s.Offset() != 5+5+13 {
+		t.Fatalf("Offset %d, want %d", s.Offset(), 23)
+	}
+	if e, _ := s.Concat("", ""); e != "" || s.Offset() != 23 {
+		t.Fatalf("empty Concat allocated")
+	}
+	if s.Len() != 0 {
+		t.Fatalf("Len %d without interning", s.Len())
+	}
+}
+
+func TestStringArena_Full(t *testing.T) {
+	s, _ := NewStringArena(8, false)
+	if _, err := s.NewString("12345678"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Concat("a", "b"); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+	s.Reset()
+	if str, err := s.StringFromBytes([]byte("abc")); err != nil || str != "abc" {
+		t.Fatalf("after Reset: %q %v", str, err)
+	}
+}
+
+func TestStringArena_Interning(t *testing.T) {
+	s, _ := NewStringArena(256, true)
+	a, _ := s.NewString("gopher")
+	b, _ := s.StringFromBytes([]byte("gopher"))
+	used := s.Offset()
+	c, _ := s.Concat("go", "pher")
+	if unsafe.StringData(a) != unsafe.StringData(b) || unsafe.StringData(a) != unsafe.StringData(c) {
+		t.Fatalf("equal strings were not deduplicated")
+	}
+	if s.Offset() != used || s.Len() != 1 {
+		t.Fatalf("duplicate Concat kept its copy: offset %d, len %d", s.Offset(), s.Len())
+	}
+	if tail := unsafe.Slice((*byte)(s.arena.Base()), s.Capacity())[used:]; strings.Trim(string(tail), "\x00") != "" {
+		t.Fatalf("rolled back bytes not zeroed")
+	}
+	s.Reset()
+	if s.Len() != 0 || s.Offset() != 0 {
+		t.Fatalf("Reset kept interned strings")
+	}
+	d, _ := s.NewString("gopher")
+	if d != "gopher" || s.Len() != 1 {
+		t.Fatalf("interning after Reset: %q", d)
+	}
+}
+
+func TestStringArena_FieldOfArenaObject(t *testing.T) {
+	type employee struct {
+		Name string
+		Age  int
+	}
+	s, _ := NewStringArena(1024, false)
+	people, _ := NewMemoryArena[employee](1024)
+	p, _ := people.NewObject(employee{Age: 30})
+	p.Name, _ = s.NewString("Ada")
+	churnHeap()
+	if p.Name != "Ada" || !inArena(s, p.Name) {
+		t.Fatalf("name %q", p.Name)
+	}
+}
+
+func BenchmarkStringArena_FromBytes(b *testing.B) {
+	s, _ := NewStringArena(1<<20, false)
+	buf := []byte("some request header value")
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := s.StringFromBytes(buf); err != nil {
+			s.Reset()
+		}
+	}
+}
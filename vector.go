@@ -0,0 +1,124 @@
+package memoryArena
+
+import "unsafe"
+
+// Vector is a growable sequence of T stored in an Arena[T].  It tracks the
+// backing slice for the caller and grows it with the arena's AppendSlice, so
+// capacity doubles (nextPow2) and a vector that is the arena's most recent
+// allocation grows in place.  When the arena cannot hold the grown vector the
+// error is returned and the vector is left unchanged.
+//
+// The elements live in the arena: a Reset of the arena invalidates the
+// vector, and Vector is no more goroutine‑safe than its arena.
+type Vector[T any] struct {
+	arena Arena[T]
+	data  []T
+}
+
+// NewVector returns an empty vector in a with room for capacity elements.
+func NewVector[T any](a Arena[T], capacity int) (*Vector[T], error) {
+	if capacity < 0 {
+		return nil, ErrInvalidSize
+	}
+	v := &Vector[T]{arena: a}
+	if err := v.Reserve(capacity); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// Push appends x, growing the vector when it is full.
+func (v *Vector[T]) Push(x T) error {
+	if len(v.data) < cap(v.data) {
+		v.data = append(v.data, x)
+		return nil
+	}
+	data, err := v.arena.AppendSlice(v.data, x)
+	if err != nil {
+		return err
+	}
+	v.data = data
+	return nil
+}
+
+// Pop removes and returns the last element.  ok is false when the vector is
+// empty.
+func (v *Vector[T]) Pop() (x T, ok bool) {
+	n := len(v.data)
+	if n == 0 {
+		return x, false
+	}
+	x = v.data[n-1]
+	var zero T
+	v.data[n-1] = zero // drop references held by the vacated slot
+	v.data = v.data[:n-1]
+	return x, true
+}
+
+// Get returns the element at index i.  It panics if i is out of range.
+func (v *Vector[T]) Get(i int) T {
+	return v.data[i]
+}
+
+// Set replaces the element at index i.  It panics if i is out of range.
+func (v *Vector[T]) Set(i int, x T) {
+	v.data[i] = x
+}
+
+// Len returns the number of elements.
+func (v *Vector[T]) Len() int {
+	return len(v.data)
+}
+
+// Cap returns the number of elements the vector holds before it must grow.
+func (v *Vector[T]) Cap() int {
+	return cap(v.data)
+}
+
+// Truncate shortens the vector to n elements, zeroing the removed ones.  It
+// panics if n is negative or greater than Len.
+func (v *Vector[T]) Truncate(n int) {
+	clear(v.data[n:])
+	v.data = v.data[:n]
+}
+
+// Reserve makes sure at least n more elements can be pushed without growing.
+// The capacity is rounded up with nextPow2 like any other growth.
+func (v *Vector[T]) Reserve(n int) error {
+	if n < 0 {
+		return ErrInvalidSize
+	}
+	need := len(v.data) + n
+	if need <= cap(v.data) || need == 0 {
+		return nil
+	}
+	var dummy T
+	newCap := nextPow2(need)
+	ptr, err := v.arena.Allocate(newCap * int(unsafe.Sizeof(dummy)))
+	if err != nil {
+		return err
+	}
+	data := unsafe.Slice((*T)(ptr), newCap)
+	v.data = data[:copy(data, v.data)]
+	return nil
+}
+
+// All returns an iterator over the index/element pairs, in order.  Its type
+// matches iter.Seq2[int, T], so on Go 1.23+ it can be ranged over directly:
+//
+//	for i, x := range v.All() { … }
+func (v *Vector[T]) All() func(yield func(int, T) bool) {
+	return func(yield func(int, T) bool) {
+		for i, x := range v.data {
+			if !yield(i, x) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns the elements as a slice sharing the vector's storage.  It is
+// only valid until the next call that grows the vector.
+func (v *Vector[T]) Slice() []T {
+	return v.data
+}
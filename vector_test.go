@@ -0,0 +1,123 @@
+package memoryArena
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestVector_PushGetSetPop(t *testing.T) {
+	a, _ := NewMemoryArena[int](4096)
+	v, err := NewVector(a, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		if err := v.Push(i); err != nil {
+			t.Fatalf("Push %d: %v", i, err)
+		}
+	}
+	if v.Len() != 100 || v.Cap() != 128 {
+		t.Fatalf("Len/Cap = %d/%d, want 100/128", v.Len(), v.Cap())
+	}
+	v.Set(10, -10)
+	if v.Get(10) != -10 || v.Get(99) != 99 {
+		t.Fatalf("Get after Set: %d %d", v.Get(10), v.Get(99))
+	}
+	if x, ok := v.Pop(); !ok || x != 99 || v.Len() != 99 {
+		t.Fatalf("Pop = %d %v, len %d", x, ok, v.Len())
+	}
+	if s := v.Slice(); s[:cap(s)][99] != 0 {
+		t.Fatalf("Pop did not zero the vacated slot")
+	}
+	v.Truncate(0)
+	if _, ok := v.Pop(); ok {
+		t.Fatalf("Pop on empty vector reported ok")
+	}
+}
+
+func TestVector_GrowsInPlaceAtTop(t *testing.T) {
+	a, _ := NewMemoryArena[int](1024)
+	v, _ := NewVector(a, 8)
+	v.Push(1)
+	first := &v.Slice()[0]
+	for i := 0; i < 15; i++ {
+		v.Push(i)
+	}
+	if &v.Slice()[0] != first || a.Offset() != 16*8 {
+		t.Fatalf("vector at the top of the arena was moved (offset %d)", a.Offset())
+	}
+}
+
+func TestVector_ArenaFull(t *testing.T) {
+	a, _ := NewMemoryArena[int64](64)
+	v, _ := NewVector(a, 0)
+	for i := 0; i < 8; i++ {
+		if err := v.Push(int64(i)); err != nil {
+			t.Fatalf("Push %d: %v", i, err)
+		}
+	}
+	if err := v.Push(8); err != ErrArenaFull {
+		t.Fatalf("want ErrArenaFull, got %v", err)
+	}
+	if v.Len() != 8 || v.Get(7) != 7 {
+		t.Fatalf("failed Push changed the vector: len %d", v.Len())
+	}
+	if err := v.Reserve(1); err != ErrArenaFull {
+		t.Fatalf("Reserve: want ErrArenaFull, got %v", err)
+	}
+	if _, err := NewVector(a, -1); err != ErrInvalidSize {
+		t.Fatalf("want ErrInvalidSize, got %v", err)
+	}
+}
+
+func TestVector_ReserveAndTruncate(t *testing.T) {
+	a, _ := NewAtomicArena[string](4096)
+	v, _ := NewVector(a, 3)
+	if v.Cap() != 8 || v.Len() != 0 {
+		t.Fatalf("Len/Cap = %d/%d, want 0/8", v.Len(), v.Cap())
+	}
+	for i := 0; i < 5; i++ {
+		v.Push(fmt.Sprint(i))
+	}
+	if err := v.Reserve(20); err != nil || v.Cap() < 25 {
+		t.Fatalf("Reserve: %v cap %d", err, v.Cap())
+	}
+	if v.Get(4) != "4" {
+		t.Fatalf("Reserve lost elements: %q", v.Get(4))
+	}
+	v.Truncate(2)
+	if v.Len() != 2 || v.Slice()[:5][3] != "" {
+		t.Fatalf("Truncate did not clear removed elements")
+	}
+}
+
+func TestVector_All(t *testing.T) {
+	a, _ := NewConcurrentArena[int](1024)
+	v, _ := NewVector(a, 0)
+	for i := 0; i < 10; i++ {
+		v.Push(i * i)
+	}
+	n := 0
+	v.All()(func(i, x int) bool {
+		if x != i*i {
+			t.Fatalf("All yielded (%d, %d)", i, x)
+		}
+		n++
+		return i < 4
+	})
+	if n != 5 {
+		t.Fatalf("All did not stop when yield returned false: %d calls", n)
+	}
+}
+
+func BenchmarkVector_Push(b *testing.B) {
+	a, _ := NewMemoryArena[int](1 << 20)
+	v, _ := NewVector(a, 0)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if v.Push(i) != nil {
+			a.Reset()
+			v, _ = NewVector(a, 0)
+		}
+	}
+}